@@ -0,0 +1,206 @@
+package textstats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SyllableSample is a word with a known number of syllables
+type SyllableSample struct {
+	Word      string
+	Syllables int
+}
+
+// LoadSyllableSamples reads words with known syllable counts from r, one per
+// line as a word followed by its count, such as "table 2". Blank lines and
+// anything following a # are ignored.
+func LoadSyllableSamples(r io.Reader) ([]SyllableSample, error) {
+	var samples []SyllableSample
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("textstats: syllable samples line %d: need a word and a count", line)
+		}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("textstats: syllable samples line %d: invalid syllable count %q", line, fields[1])
+		}
+
+		samples = append(samples, SyllableSample{Word: fields[0], Syllables: count})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return samples, nil
+}
+
+// LoadSyllableSamplesFile reads words with known syllable counts from the
+// named file. See LoadSyllableSamples for the format.
+func LoadSyllableSamplesFile(name string) ([]SyllableSample, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadSyllableSamples(f)
+}
+
+// SyllableMiss is a word that was given the wrong number of syllables
+type SyllableMiss struct {
+	SyllableSample
+
+	// Counted is the number of syllables the word was given
+	Counted int
+}
+
+// RuleReport shows how a single syllable rule affects accuracy, found by
+// counting every word again without the rule
+type RuleReport struct {
+	// Kind is the list the rule is in: "sub", "add" or "prefix/suffix"
+	Kind string
+
+	// Rule is the rule's regular expression
+	Rule string
+
+	// Fixed is the number of words that are only counted correctly because of
+	// the rule
+	Fixed int
+
+	// Broken is the number of words that would be counted correctly without
+	// the rule
+	Broken int
+}
+
+// Net returns the number of words the rule fixes, less those it breaks
+func (r RuleReport) Net() int {
+	return r.Fixed - r.Broken
+}
+
+// SyllableReport is the accuracy of a SyllableCounter against words with
+// known syllable counts
+type SyllableReport struct {
+	// Words is the number of words checked
+	Words int
+
+	// Correct is the number of words counted correctly
+	Correct int
+
+	// Over and Under are the number of words given too many and too few
+	// syllables
+	Over, Under int
+
+	// Confusion maps the difference between the counted and known syllables
+	// to the number of words that were out by it. Correct words aren't
+	// included.
+	Confusion map[int]int
+
+	// Misses are the words counted incorrectly, in the order checked
+	Misses []SyllableMiss
+
+	// Rules reports each rule when the counter is a *SyllableRules, from the
+	// one that does the most harm to the one that does the most good
+	Rules []RuleReport
+}
+
+// Accuracy returns the fraction of words counted correctly
+func (r *SyllableReport) Accuracy() float64 {
+	if r.Words == 0 {
+		return 0
+	}
+	return float64(r.Correct) / float64(r.Words)
+}
+
+// EvaluateSyllables checks a SyllableCounter against words with known syllable
+// counts. If counter is nil, the default syllable rules are used.
+func EvaluateSyllables(counter SyllableCounter, samples []SyllableSample) *SyllableReport {
+	if counter == nil {
+		counter = defaultSyllableRules
+	}
+
+	report := &SyllableReport{Confusion: make(map[int]int)}
+	correct := make([]bool, len(samples))
+	for i, sample := range samples {
+		counted := counter.Syllables(sample.Word)
+		report.Words++
+
+		diff := counted - sample.Syllables
+		switch {
+		case diff == 0:
+			report.Correct++
+			correct[i] = true
+			continue
+		case diff > 0:
+			report.Over++
+		default:
+			report.Under++
+		}
+
+		report.Confusion[diff]++
+		report.Misses = append(report.Misses, SyllableMiss{SyllableSample: sample, Counted: counted})
+	}
+
+	if rules, ok := counter.(*SyllableRules); ok {
+		report.Rules = evaluateRules(rules, samples, correct)
+	}
+
+	return report
+}
+
+// evaluateRules counts the samples again without each rule in turn, to see
+// which words it fixes and breaks
+func evaluateRules(rules *SyllableRules, samples []SyllableSample, correct []bool) []RuleReport {
+	lists := []struct {
+		kind  string
+		rules func(*SyllableRules) *[]*regexp.Regexp
+	}{
+		{"sub", func(r *SyllableRules) *[]*regexp.Regexp { return &r.SubSyllables }},
+		{"add", func(r *SyllableRules) *[]*regexp.Regexp { return &r.AddSyllables }},
+		{"prefix/suffix", func(r *SyllableRules) *[]*regexp.Regexp { return &r.PrefixSuffixes }},
+	}
+
+	var reports []RuleReport
+	for _, list := range lists {
+		for i, rule := range *list.rules(rules) {
+			without := *rules
+			field := list.rules(&without)
+			*field = append(append([]*regexp.Regexp(nil), (*field)[:i]...), (*field)[i+1:]...)
+
+			report := RuleReport{Kind: list.kind, Rule: rule.String()}
+			for j, sample := range samples {
+				switch without.Syllables(sample.Word) == sample.Syllables {
+				case correct[j]:
+				case true:
+					report.Broken++
+				default:
+					report.Fixed++
+				}
+			}
+			reports = append(reports, report)
+		}
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Net() < reports[j].Net()
+	})
+
+	return reports
+}
@@ -0,0 +1,77 @@
+package textstats
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccuracySuite struct {
+	suite.Suite
+}
+
+func (s *AccuracySuite) TestLoadSamples() {
+	samples, err := LoadSyllableSamples(strings.NewReader("# comment\ntable 2\n\nhello 2 # greeting\n"))
+	s.NoError(err)
+	s.Equal([]SyllableSample{{"table", 2}, {"hello", 2}}, samples)
+
+	_, err = LoadSyllableSamples(strings.NewReader("table"))
+	s.Error(err)
+
+	_, err = LoadSyllableSamples(strings.NewReader("table two"))
+	s.Error(err)
+}
+
+func (s *AccuracySuite) TestEvaluate() {
+	samples := []SyllableSample{{"one", 1}, {"two", 2}, {"three", 1}, {"four", 3}}
+	counter := SyllableCounterFunc(func(string) int { return 1 })
+
+	report := EvaluateSyllables(counter, samples)
+	s.Equal(4, report.Words)
+	s.Equal(2, report.Correct)
+	s.Equal(0, report.Over)
+	s.Equal(2, report.Under)
+	s.Equal(map[int]int{-1: 1, -2: 1}, report.Confusion)
+	s.Equal([]SyllableMiss{{SyllableSample{"two", 2}, 1}, {SyllableSample{"four", 3}, 1}}, report.Misses)
+	s.Equal(0.5, report.Accuracy())
+	s.Nil(report.Rules)
+
+	s.Equal(0.0, EvaluateSyllables(counter, nil).Accuracy())
+}
+
+func (s *AccuracySuite) TestRules() {
+	rules := &SyllableRules{
+		AddSyllables: []*regexp.Regexp{regexp.MustCompile(`ia`), regexp.MustCompile(`ea`)},
+	}
+	samples := []SyllableSample{{"piano", 3}, {"media", 3}, {"bread", 1}, {"beach", 1}, {"create", 2}}
+
+	report := EvaluateSyllables(rules, samples)
+	s.Equal(2, report.Correct)
+	s.Equal([]RuleReport{
+		{Kind: "add", Rule: "ea", Fixed: 0, Broken: 3},
+		{Kind: "add", Rule: "ia", Fixed: 2, Broken: 0},
+	}, report.Rules)
+	s.Equal(-3, report.Rules[0].Net())
+
+	// evaluating rules doesn't change them
+	s.Len(rules.AddSyllables, 2)
+}
+
+func (s *AccuracySuite) TestReferenceCorpus() {
+	samples, err := LoadSyllableSamplesFile("testdata/syllables.txt")
+	s.Require().NoError(err)
+
+	report := EvaluateSyllables(nil, samples)
+	s.Equal(len(samples), report.Words)
+	s.Equal(report.Words, report.Correct+report.Over+report.Under)
+	s.Len(report.Rules, len(subSyllables)+len(addSyllables)+len(prefixSuffixes))
+
+	// guard against rule changes that make the default counter worse
+	s.True(report.Accuracy() > 0.85, "accuracy %f", report.Accuracy())
+}
+
+func TestAccuracy(t *testing.T) {
+	suite.Run(t, new(AccuracySuite))
+}
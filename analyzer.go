@@ -0,0 +1,151 @@
+package textstats
+
+import (
+	"bufio"
+	"sync"
+)
+
+// Analyzer analyses the text written to it, so that text can be analysed as it
+// streams past without buffering it or wrapping it in an io.Reader. Words and
+// sentences can be split across writes. An Analyzer is safe for concurrent
+// use.
+type Analyzer struct {
+	mu sync.Mutex
+	a  *analyser
+	// buf holds text whose token may continue in the next write
+	buf []byte
+	// err stops any more text being analysed, and done is true if the
+	// Tokenizer stopped the analysis with bufio.ErrFinalToken
+	err  error
+	done bool
+}
+
+// NewAnalyzer returns an Analyzer with the given options. Write returns a
+// LimitError once the text goes past a limit set with WithMaxBytes or
+// WithMaxWords, while WithTimeout has no effect.
+func NewAnalyzer(opts ...Option) *Analyzer {
+	return &Analyzer{a: newAnalyser(newConfig(opts))}
+}
+
+// Write implements io.Writer
+func (z *Analyzer) Write(p []byte) (int, error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.err != nil || z.done {
+		return z.stopped(len(p))
+	}
+
+	pending := len(z.buf)
+	z.buf = append(z.buf, p...)
+	return z.flush(pending, len(p))
+}
+
+// WriteString implements io.StringWriter
+func (z *Analyzer) WriteString(s string) (int, error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.err != nil || z.done {
+		return z.stopped(len(s))
+	}
+
+	pending := len(z.buf)
+	z.buf = append(z.buf, s...)
+	return z.flush(pending, len(s))
+}
+
+// stopped returns the result of writing n bytes once the analysis has stopped
+func (z *Analyzer) stopped(n int) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	return n, nil
+}
+
+// flush analyses the complete tokens in the buffer, which held pending bytes
+// before n were written
+func (z *Analyzer) flush(pending, n int) (int, error) {
+	used, err := analyseTokens(z.a, z.buf, false)
+	z.buf = append(z.buf[:0], z.buf[used:]...)
+
+	switch {
+	case err == bufio.ErrFinalToken:
+		z.done = true
+		return n, nil
+	case err == nil:
+		return n, nil
+	}
+
+	z.err = err
+	written := used - pending
+	switch {
+	case written < 0:
+		written = 0
+	case written > n:
+		written = n
+	}
+	return written, err
+}
+
+// Results returns the results for the text written so far, as if it ended
+// there. Later writes don't change the returned Results.
+func (z *Analyzer) Results() *Results {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	a := z.a.clone()
+	if z.err == nil && !z.done {
+		analyseTokens(a, z.buf, true)
+	}
+	a.finish()
+
+	return a.res
+}
+
+// analyseTokens adds the complete tokens at the start of data to a, returning
+// the number of bytes they used
+func analyseTokens(a *analyser, data []byte, atEOF bool) (int, error) {
+	var used int
+	for used < len(data) {
+		n, kind, err := nextToken(a.cfg.tokenizer, data[used:], atEOF)
+		if n > 0 {
+			a.token(kind, data[used:used+n])
+			used += n
+		}
+
+		switch {
+		case err != nil:
+			return used, err
+		case n == 0:
+			return used, nil
+		}
+
+		if err := a.limit(); err != nil {
+			return used, err
+		}
+	}
+
+	return used, nil
+}
+
+// clone returns a copy of the analyser that can carry on without changing it
+func (a *analyser) clone() *analyser {
+	c := *a
+
+	c.res = a.res.clone()
+	c.para = c.res
+	if a.para != a.res {
+		c.para = a.para.clone()
+	}
+	c.cur = c.para
+	if a.cur != a.para {
+		c.cur = a.cur.clone()
+	}
+
+	c.seg.chunk = append([]byte(nil), a.seg.chunk...)
+	c.text = append([]byte(nil), a.text...)
+	c.seg.lower, c.letters, c.parts, c.lower, c.runes, c.folded, c.familiar = nil, nil, nil, nil, nil, nil, nil
+
+	return &c
+}
@@ -0,0 +1,106 @@
+package textstats
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AnalyzerSuite struct {
+	suite.Suite
+}
+
+func (s *AnalyzerSuite) TestSplitWrites() {
+	text := paragraphs + "Dr. Smith's well-\nknown café, https://example.com... “Really?” " + qbf
+	opts := []Option{WithWordDetails(), WithSentenceDetails(), WithParagraphDetails()}
+	want, _ := Analyse(strings.NewReader(text), opts...)
+
+	for i := 0; i <= len(text); i++ {
+		z := NewAnalyzer(opts...)
+		z.WriteString(text[:i])
+		z.Write([]byte(text[i:]))
+		s.Equal(want, z.Results(), "split at %d", i)
+	}
+
+	z := NewAnalyzer(opts...)
+	for i := 0; i < len(text); i++ {
+		z.Write([]byte{text[i]})
+	}
+	s.Equal(want, z.Results())
+}
+
+func (s *AnalyzerSuite) TestSnapshots() {
+	z := NewAnalyzer()
+	s.Equal(0, z.Results().Words)
+
+	io.WriteString(z, "The quick brown fo")
+	res := z.Results()
+	s.Equal(4, res.Words)
+	s.Equal(1, res.Sentences)
+
+	io.WriteString(z, "xes jumped. Then")
+	s.Equal(4, res.Words)
+	s.Equal(6, z.Results().Words)
+	s.Equal(2, z.Results().Sentences)
+
+	io.WriteString(z, "\n\nThe end.")
+	res = z.Results()
+	s.Equal(8, res.Words)
+	s.Equal(3, res.Sentences)
+	s.Equal(2, res.Paragraphs)
+	s.Equal(res, z.Results())
+}
+
+func (s *AnalyzerSuite) TestCopy() {
+	z := NewAnalyzer()
+	n, err := io.Copy(z, strings.NewReader(lorem))
+	s.NoError(err)
+	s.Equal(int64(len(lorem)), n)
+
+	want, _ := Analyse(strings.NewReader(lorem))
+	s.Equal(want, z.Results())
+}
+
+func (s *AnalyzerSuite) TestLongRuns() {
+	text := strings.Repeat(" ", 70000) + "Hello " + strings.Repeat("é", 70000) + "\n\nBye."
+	want, err := Analyse(strings.NewReader(text))
+	s.Require().NoError(err)
+
+	for _, size := range []int{len(text), 1 << 16, 1 << 15, 1 << 13} {
+		z := NewAnalyzer()
+		for i := 0; i < len(text); i += size {
+			end := i + size
+			if end > len(text) {
+				end = len(text)
+			}
+			_, err := z.WriteString(text[i:end])
+			s.NoError(err)
+		}
+		s.Equal(want, z.Results(), "writes of %d bytes", size)
+	}
+}
+
+func (s *AnalyzerSuite) TestLimits() {
+	z := NewAnalyzer(WithMaxWords(5))
+	n, err := z.WriteString("One two three. ")
+	s.NoError(err)
+	s.Equal(15, n)
+
+	n, err = z.WriteString("Four five six seven")
+	var limit *LimitError
+	s.Require().True(errors.As(err, &limit))
+	s.Equal(WordsLimit, limit.Limit)
+	s.Equal(13, n)
+
+	n, err = z.WriteString("eight")
+	s.Equal(err, limit)
+	s.Equal(0, n)
+	s.Equal(6, z.Results().Words)
+}
+
+func TestAnalyzer(t *testing.T) {
+	suite.Run(t, new(AnalyzerSuite))
+}
@@ -0,0 +1,74 @@
+package textstats
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"strings"
+	"sync"
+)
+
+// analysisCacheSize is the number of texts the string helpers remember the
+// analysis of
+const analysisCacheSize = 64
+
+// analysisCache remembers the Results for the texts analysed most recently,
+// keyed by a hash of their content, so that asking for several scores for
+// the same text only analyses it once. The Results it holds must not be
+// changed.
+type analysisCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[[sha256.Size]byte]*list.Element
+	// recent holds the cacheEntry values, most recently used first
+	recent *list.List
+}
+
+type cacheEntry struct {
+	key [sha256.Size]byte
+	res *Results
+}
+
+func newAnalysisCache(size int) *analysisCache {
+	return &analysisCache{
+		size:    size,
+		entries: make(map[[sha256.Size]byte]*list.Element, size),
+		recent:  list.New(),
+	}
+}
+
+// stringCache is the cache used by the string helpers
+var stringCache = newAnalysisCache(analysisCacheSize)
+
+// analyse returns the Results for text with the default options, analysing
+// it only if it isn't in the cache
+func (c *analysisCache) analyse(text string) *Results {
+	key := sha256.Sum256([]byte(text))
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.recent.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*cacheEntry).res
+	}
+	c.mu.Unlock()
+
+	res, _ := Analyse(strings.NewReader(text))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Another caller may have analysed the same text meanwhile
+	if e, ok := c.entries[key]; ok {
+		c.recent.MoveToFront(e)
+		return e.Value.(*cacheEntry).res
+	}
+
+	c.entries[key] = c.recent.PushFront(&cacheEntry{key: key, res: res})
+	if c.recent.Len() > c.size {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+
+	return res
+}
@@ -0,0 +1,34 @@
+package textstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CacheSuite struct {
+	suite.Suite
+}
+
+func (s *CacheSuite) TestEviction() {
+	c := newAnalysisCache(2)
+
+	first := c.analyse(qbf)
+	s.Equal(9, first.Words)
+	s.Same(first, c.analyse(qbf))
+
+	c.analyse(hw)
+	c.analyse(qbf)
+	c.analyse(lorem)
+	s.Equal(2, c.recent.Len())
+	s.Len(c.entries, 2)
+	s.Same(first, c.analyse(qbf), "the most recently used text is kept")
+
+	again := c.analyse(hw)
+	s.Equal(first.Words, c.analyse(qbf).Words)
+	s.Same(again, c.analyse(hw))
+}
+
+func TestCache(t *testing.T) {
+	suite.Run(t, new(CacheSuite))
+}
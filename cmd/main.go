@@ -9,15 +9,18 @@ import (
 )
 
 func printStats(name string, res *textstats.Results) {
+	scores := res.AllScores()
 	fmt.Printf("Statistics for %q:\n", name)
 	fmt.Printf(`
 	Words              %d
 	Sentences          %d
+	Paragraphs         %d
 	Letters            %d
 	Punctuation        %d
 	Spaces             %d
 	Syllables          %d
 	Difficult Words    %d
+	Complex Words      %d
 	Avg Letters/Word   %f
 	Avg Syllables/Word %f
 	Avg Words/Sentence %f
@@ -34,25 +37,39 @@ Readability Scores:
 `,
 		res.Words,
 		res.Sentences,
+		res.Paragraphs,
 		res.Letters,
 		res.Punctuation,
 		res.Spaces,
 		res.Syllables,
 		res.DifficultWords,
+		res.ComplexWords,
 		res.AverageLettersPerWord(),
 		res.AverageSyllablesPerWord(),
 		res.AverageWordsPerSentence(),
-		res.FleschKincaidReadingEase(),
-		res.FleschKincaidGradeLevel(),
-		res.GunningFogScore(),
-		res.ColemanLiauIndex(),
-		res.SMOGIndex(),
-		res.AutomatedReadabilityIndex(),
-		res.DaleChallReadabilityScore(),
+		scores.FleschKincaidReadingEase,
+		scores.FleschKincaidGradeLevel,
+		scores.GunningFogScore,
+		scores.ColemanLiauIndex,
+		scores.SMOGIndex,
+		scores.AutomatedReadabilityIndex,
+		scores.DaleChallReadabilityScore,
 	)
+
+	if warnings := res.Warnings(); len(warnings) > 0 {
+		fmt.Printf("Warnings:\n")
+		for _, warning := range warnings {
+			fmt.Printf("\t%s\n", warning)
+		}
+		fmt.Println()
+	}
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "syllables" {
+		os.Exit(syllables(os.Args[0], os.Args[2:]))
+	}
+
 	if !termutil.Isatty(os.Stdin.Fd()) {
 		res, err := textstats.Analyse(os.Stdin)
 		if err != nil {
@@ -67,17 +84,11 @@ func main() {
 	if len(os.Args) == 1 || len(os.Args) > 2 {
 		fmt.Println(os.Args[0])
 		fmt.Println("Usage:", os.Args[0], "[filename]")
+		fmt.Println("      ", os.Args[0], "syllables [flags] corpus")
 		os.Exit(1)
 	}
 
-	f, err := os.Open(os.Args[1])
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
-	defer f.Close()
-
-	res, err := textstats.Analyse(f)
+	res, err := textstats.AnalyseFile(os.Args[1])
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
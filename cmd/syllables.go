@@ -0,0 +1,118 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/darkliquid/textstats"
+)
+
+var counters = map[string]func(cmudict string) (textstats.SyllableCounter, error){
+	"rules": func(string) (textstats.SyllableCounter, error) { return textstats.DefaultSyllableRules(), nil },
+	"cmudict": func(cmudict string) (textstats.SyllableCounter, error) {
+		if cmudict == "" {
+			return nil, fmt.Errorf("the cmudict counter needs the -cmudict flag")
+		}
+		return textstats.LoadCMUDictFile(cmudict)
+	},
+	"hyphen": func(string) (textstats.SyllableCounter, error) { return textstats.NewHyphenator(), nil },
+}
+
+// syllables scores a syllable counter against a corpus of words with known
+// syllable counts, returning the exit code
+func syllables(name string, args []string) int {
+	flags := flag.NewFlagSet(name+" syllables", flag.ContinueOnError)
+	counterName := flags.String("counter", "rules", "syllable counter to check: rules, cmudict or hyphen")
+	cmudict := flags.String("cmudict", "", "CMU Pronouncing Dictionary file for the cmudict counter, such as cmudict.dict")
+	worst := flags.Int("rules", 10, "number of the worst rules to show")
+	misses := flags.Int("misses", 0, "number of miscounted words to show")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:", name, "syllables [flags] corpus")
+		fmt.Fprintln(flags.Output(), "\nThe corpus has a word and its syllable count on each line.")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	newCounter, ok := counters[*counterName]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown syllable counter %q\n", *counterName)
+		return 2
+	}
+	counter, err := newCounter(*cmudict)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	samples, err := textstats.LoadSyllableSamplesFile(flags.Arg(0))
+	if err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	report := textstats.EvaluateSyllables(counter, samples)
+	printReport(report, *worst, *misses)
+
+	return 0
+}
+
+func printReport(report *textstats.SyllableReport, worst, misses int) {
+	fmt.Printf(`Syllable counts:
+
+	Words     %d
+	Correct   %d
+	Accuracy  %.2f%%
+	Over      %d
+	Under     %d
+`,
+		report.Words,
+		report.Correct,
+		report.Accuracy()*100,
+		report.Over,
+		report.Under,
+	)
+
+	if len(report.Confusion) > 0 {
+		diffs := make([]int, 0, len(report.Confusion))
+		for diff := range report.Confusion {
+			diffs = append(diffs, diff)
+		}
+		sort.Ints(diffs)
+
+		fmt.Printf("\nOut by:\n\n")
+		for _, diff := range diffs {
+			fmt.Printf("\t%+d  %d\n", diff, report.Confusion[diff])
+		}
+	}
+
+	if worst > len(report.Rules) {
+		worst = len(report.Rules)
+	}
+	if worst > 0 {
+		fmt.Printf("\nWorst rules:\n\n\tNet  Fixed  Broken  Rule\n")
+		for _, rule := range report.Rules[:worst] {
+			fmt.Printf("\t%+3d  %5d  %6d  %s %s\n", rule.Net(), rule.Fixed, rule.Broken, rule.Kind, rule.Rule)
+		}
+	}
+
+	if misses > len(report.Misses) {
+		misses = len(report.Misses)
+	}
+	if misses > 0 {
+		fmt.Printf("\nMiscounted words:\n\n")
+		for _, miss := range report.Misses[:misses] {
+			fmt.Printf("\t%s  %d, not %d\n", miss.Word, miss.Counted, miss.Syllables)
+		}
+	}
+
+	fmt.Println()
+}
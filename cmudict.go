@@ -0,0 +1,121 @@
+package textstats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// CMUDict is a SyllableCounter that looks words up in the CMU Pronouncing
+// Dictionary (https://github.com/cmusphinx/cmudict), counting the vowel
+// phonemes, which carry a stress digit, in their pronunciation. The
+// dictionary isn't embedded, so load it with LoadCMUDict or LoadCMUDictFile.
+type CMUDict struct {
+	words map[string]int
+
+	// Fallback counts the syllables in words that aren't in the dictionary. If
+	// nil, the default syllable rules are used.
+	Fallback SyllableCounter
+}
+
+// LoadCMUDict reads a dictionary in the CMU Pronouncing Dictionary format,
+// where each line holds a word and its phonemes, such as "hello HH AH0 L OW1".
+// Lines starting with ";;;" and anything after a "#" are comments. Only the
+// first pronunciation of each word is used.
+func LoadCMUDict(r io.Reader) (*CMUDict, error) {
+	d := &CMUDict{words: make(map[string]int)}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if strings.HasPrefix(text, ";;;") {
+			continue
+		}
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) == 1 {
+			return nil, fmt.Errorf("textstats: cmudict line %d: missing pronunciation", line)
+		}
+
+		// Alternate pronunciations are marked like "WORD(1)"
+		if strings.HasSuffix(fields[0], ")") {
+			continue
+		}
+
+		word := cmudictKey(fields[0])
+		if _, ok := d.words[word]; ok || word == "" {
+			continue
+		}
+
+		var count int
+		for _, phoneme := range fields[1:] {
+			if last := phoneme[len(phoneme)-1]; last >= '0' && last <= '2' {
+				count++
+			}
+		}
+		d.words[word] = count
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// LoadCMUDictFile reads a dictionary in the CMU Pronouncing Dictionary format
+// from the named file, such as cmudict.dict
+func LoadCMUDictFile(name string) (*CMUDict, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadCMUDict(f)
+}
+
+// cmudictKey normalises a word to match the words seen by a
+// SyllableCounter, which are lower case and only contain letters
+func cmudictKey(word string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, word)
+}
+
+// Lookup returns the number of syllables in a word, if it is in the
+// dictionary
+func (d *CMUDict) Lookup(word string) (int, bool) {
+	count, ok := d.words[cmudictKey(word)]
+	return count, ok
+}
+
+// Len returns the number of words in the dictionary
+func (d *CMUDict) Len() int {
+	return len(d.words)
+}
+
+// Syllables implements SyllableCounter
+func (d *CMUDict) Syllables(word string) int {
+	if count, ok := d.Lookup(word); ok {
+		return count
+	}
+
+	if d.Fallback != nil {
+		return d.Fallback.Syllables(word)
+	}
+
+	return defaultSyllableRules.Syllables(word)
+}
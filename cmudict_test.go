@@ -0,0 +1,112 @@
+package textstats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// cmudictSample is a few entries as they appear in the CMU Pronouncing
+// Dictionary
+const cmudictSample = `;;; comment
+a AH0
+age EY1 JH
+museum M Y UW0 Z IY1 AH0 M
+museum(2) M Y UW1 Z IY0 AH0 M
+nice N AY1 S
+place P L EY1 S
+really R IH1 L IY0
+really(2) R IY1 L IY0
+to T UW1
+well W EH1 L
+you'll Y UW1 L
+zhivago ZH IH0 V AA1 G OW0 # foreign
+`
+
+type CMUDictSuite struct {
+	suite.Suite
+}
+
+func (s *CMUDictSuite) dict() *CMUDict {
+	d, err := LoadCMUDict(strings.NewReader(cmudictSample))
+	s.Require().NoError(err)
+	return d
+}
+
+func (s *CMUDictSuite) TestLookup() {
+	d := s.dict()
+	s.Equal(10, d.Len())
+
+	words := map[string]int{
+		"age":     1,
+		"nice":    1,
+		"really":  2,
+		"Museum":  3,
+		"you'll":  1,
+		"youll":   1,
+		"Zhivago": 3,
+	}
+	for word, count := range words {
+		n, ok := d.Lookup(word)
+		s.True(ok, word)
+		s.Equal(count, n, word)
+		s.Equal(count, d.Syllables(word), word)
+	}
+
+	_, ok := d.Lookup("floccinaucinihilipilification")
+	s.False(ok)
+}
+
+func (s *CMUDictSuite) TestFallback() {
+	d := s.dict()
+	s.Equal(SyllableCount("gopher"), d.Syllables("gopher"))
+
+	d.Fallback = SyllableCounterFunc(func(string) int { return 9 })
+	s.Equal(9, d.Syllables("gopher"))
+	s.Equal(1, d.Syllables("nice"))
+}
+
+func (s *CMUDictSuite) TestLoad() {
+	d, err := LoadCMUDict(strings.NewReader(`;;; comment
+GOPHER  G OW1 F ER0
+GOPHER(1)  G OW1 F ER0 Z
+ISN'T  IH1 Z AH0 N T
+
+`))
+	s.NoError(err)
+	s.Equal(2, d.Len())
+	s.Equal(2, d.Syllables("gopher"))
+	s.Equal(2, d.Syllables("isnt"))
+
+	_, err = LoadCMUDict(strings.NewReader("GOPHER"))
+	s.Error(err)
+}
+
+func (s *CMUDictSuite) TestLoadFile() {
+	name := filepath.Join(s.T().TempDir(), "cmudict.dict")
+	s.Require().NoError(os.WriteFile(name, []byte(cmudictSample), 0644))
+
+	d, err := LoadCMUDictFile(name)
+	s.Require().NoError(err)
+	s.Equal(s.dict(), d)
+
+	_, err = LoadCMUDictFile(filepath.Join(s.T().TempDir(), "missing"))
+	s.Error(err)
+}
+
+func (s *CMUDictSuite) TestAnalyse() {
+	text := "A nice place to age really well."
+
+	res, _ := Analyse(strings.NewReader(text))
+	s.Equal(4, res.Syllables)
+
+	res, _ = Analyse(strings.NewReader(text), WithSyllableCounter(s.dict()))
+	s.Equal(8, res.Syllables)
+}
+
+func TestCMUDict(t *testing.T) {
+	suite.Run(t, new(CMUDictSuite))
+}
@@ -0,0 +1,98 @@
+package textstats
+
+import "unicode/utf8"
+
+// ComplexWordMode controls which words count as complex for the Gunning-Fog
+// score
+type ComplexWordMode int
+
+const (
+	// ComplexWordsGunning follows Gunning's definition: words of three or more
+	// syllables are complex, apart from proper nouns, familiar words, compounds
+	// of simpler words such as "bookkeeper" or "well-known", and words only
+	// made three syllables by an "-es", "-ed" or "-ing" ending
+	ComplexWordsGunning ComplexWordMode = iota
+	// ComplexWordsLegacy counts every word of three or more syllables that
+	// isn't capitalised, as earlier versions did, so that scores stay
+	// comparable with theirs. Unless WithProperNouns or
+	// WithCapitalisedProperNouns is used as well, every capitalised word is
+	// treated as a proper noun, including the first word of each sentence.
+	ComplexWordsLegacy
+)
+
+// minCompoundPart is the fewest letters each part of a closed compound such
+// as "bookkeeper" can have, so that words aren't split into fragments such
+// as "a" and "nother"
+const minCompoundPart = 3
+
+// isComplex reports whether a word of sCount syllables counts as complex for
+// the Gunning-Fog score. compound is true if the word was hyphenated and each
+// of its parts has fewer than three syllables.
+func (a *analyser) isComplex(word []byte, sCount int, properNoun, difficult, compound bool) bool {
+	switch {
+	case sCount < 3, properNoun:
+		return false
+	case a.cfg.complexWords == ComplexWordsLegacy:
+		return true
+	case !difficult, compound:
+		return false
+	}
+
+	// Capitalised words, such as the first word of a sentence, are checked
+	// in lower case, as the word lists are
+	a.folded = appendLower(a.folded[:0], word)
+	return a.isDifficult(a.folded) && !a.isSuffixed(a.folded) && !a.isClosedCompound(a.folded)
+}
+
+// isSuffixed reports whether a word ends in "-es", "-ed" or "-ing" and has
+// fewer than three syllables without it
+func (a *analyser) isSuffixed(word []byte) bool {
+	for _, suffix := range []string{"es", "ed", "ing"} {
+		n := len(word) - len(suffix)
+		if n <= 0 || !hasSuffixFold(word, suffix) {
+			continue
+		}
+		return a.syllables(word[:n]) < 3
+	}
+	return false
+}
+
+// hasSuffixFold reports whether word ends in the lower case ASCII suffix,
+// ignoring case
+func hasSuffixFold(word []byte, suffix string) bool {
+	if len(word) < len(suffix) {
+		return false
+	}
+	word = word[len(word)-len(suffix):]
+	for i := range word {
+		if word[i]|0x20 != suffix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// maxCompoundLength is the longest word, in bytes, that is checked for being
+// a closed compound. No two familiar words make a longer one, and checking
+// every split of a long word takes quadratic time.
+const maxCompoundLength = 32
+
+// isClosedCompound reports whether a word is made of two familiar words that
+// each have fewer than three syllables, such as "bookkeeper"
+func (a *analyser) isClosedCompound(word []byte) bool {
+	if len(word) > maxCompoundLength {
+		return false
+	}
+	for i := minCompoundPart; i <= len(word)-minCompoundPart; i++ {
+		if utf8.RuneStart(word[i]) && a.isSimple(word[:i]) && a.isSimple(word[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSimple reports whether a word is familiar and has fewer than three
+// syllables
+func (a *analyser) isSimple(word []byte) bool {
+	return !a.isDifficult(word) && a.syllables(word) < 3
+}
@@ -0,0 +1,98 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ComplexSuite struct {
+	suite.Suite
+}
+
+// complexWords returns the words of three or more syllables in text, and
+// whether each was counted as complex
+func (s *ComplexSuite) complexWords(text string, opts ...Option) map[string]bool {
+	res, _ := Analyse(strings.NewReader(text), append(opts, WithWordDetails())...)
+
+	words := make(map[string]bool)
+	for _, w := range res.WordDetails {
+		if w.Syllables >= 3 {
+			words[w.Word] = w.Complex
+		}
+	}
+	return words
+}
+
+func (s *ComplexSuite) TestGunning() {
+	s.Equal(map[string]bool{
+		"bookkeeper":   false,
+		"visiting":     false,
+		"everybody":    false,
+		"Beautiful":    false,
+		"policies":     true,
+		"consolidated": true,
+	}, s.complexWords("The bookkeeper was visiting everybody. Beautiful policies were consolidated."))
+
+	s.Equal(map[string]bool{
+		"wellinformed": false,
+		"institutions": true,
+	}, s.complexWords("The well-informed institutions.", WithHyphenMode(HyphenCompound)))
+
+	words := s.complexWords("The Kubernetes administrators.", WithFamiliarWords(NewLexicon("administrators")))
+	s.Equal(map[string]bool{"Kubernetes": false, "administrators": false}, words)
+}
+
+// baselineFog are Gunning-Fog scores from before complex words were counted
+// by Gunning's definition, which ComplexWordsLegacy reproduces
+var baselineFog = []struct {
+	text  string
+	score float64
+}{
+	{lorem, 19.073913043478264},
+	{"Everybody understood the complicated instructions. Beautiful butterflies " +
+		"visited Alexandria yesterday. Nobody expected the consolidated policies.", 26},
+	{"Institutions everywhere are considering electricity. The Mississippi " +
+		"Company delivered seventeen generators to Indianapolis.", 21.06153846153846},
+}
+
+func (s *ComplexSuite) TestLegacy() {
+	text := "The bookkeeper was visiting everybody. Beautiful policies were consolidated."
+	s.Equal(map[string]bool{
+		"bookkeeper":   true,
+		"visiting":     true,
+		"everybody":    true,
+		"Beautiful":    false,
+		"policies":     true,
+		"consolidated": true,
+	}, s.complexWords(text, WithComplexWordMode(ComplexWordsLegacy)))
+
+	for _, baseline := range baselineFog {
+		res, _ := Analyse(strings.NewReader(baseline.text), WithComplexWordMode(ComplexWordsLegacy))
+		s.Equal(res.WordsWithAtLeastNSyllables(3, false), res.ComplexWords, baseline.text)
+		s.Equal(baseline.score, res.GunningFogScore(), baseline.text)
+
+		res, _ = Analyse(strings.NewReader(baseline.text), WithComplexWordMode(ComplexWordsLegacy), WithCapitalisedProperNouns())
+		s.Equal(baseline.score, res.GunningFogScore(), baseline.text)
+	}
+
+	// A proper noun rule that is set explicitly is kept
+	words := s.complexWords(text, WithComplexWordMode(ComplexWordsLegacy), WithProperNouns(nil))
+	s.True(words["Beautiful"])
+}
+
+func (s *ComplexSuite) TestGunningFogScore() {
+	text := "The bookkeeper was visiting everybody. Beautiful policies were consolidated."
+	res, _ := Analyse(strings.NewReader(text))
+	s.Equal(2, res.ComplexWords)
+	s.InDelta((4.5+100.0*2/9)*0.4, res.GunningFogScore(), 1e-9)
+
+	res, _ = Analyse(strings.NewReader(text), WithComplexWordMode(ComplexWordsLegacy))
+	s.Equal(5, res.ComplexWords)
+	s.InDelta((4.5+100.0*5/9)*0.4, res.GunningFogScore(), 1e-9)
+}
+
+func TestComplexWords(t *testing.T) {
+	suite.Run(t, new(ComplexSuite))
+}
@@ -2,21 +2,51 @@ package textstats
 
 import "regexp"
 
+// The exported word lists and rules are copies of the built in defaults, which
+// never change. Changing them has no effect on any analysis.
 var (
-	consonantsRegexp = regexp.MustCompile("[^aeiouy]+")
-	pluralRegexp     = regexp.MustCompile("([a-zA-Z]+?)(s\\b|\\b)")
+	// ProblemWords are words that don't follow typical syllable counting rules
+	//
+	// Deprecated: changing ProblemWords has no effect. Use WithDictionary, or
+	// the ProblemWords of DefaultSyllableRules with WithSyllableRules.
+	ProblemWords = copyCounts(problemWords)
+
+	// SubSyllables are syllables that would be counted as two but should be one
+	//
+	// Deprecated: changing SubSyllables has no effect. Use the SubSyllables of
+	// DefaultSyllableRules with WithSyllableRules.
+	SubSyllables = subSyllables
+
+	// AddSyllables are syllables that would be counted as one but should be two
+	//
+	// Deprecated: changing AddSyllables has no effect. Use the AddSyllables of
+	// DefaultSyllableRules with WithSyllableRules.
+	AddSyllables = addSyllables
+
+	// PrefixSuffixes are single syllable prefixes and suffixes
+	//
+	// Deprecated: changing PrefixSuffixes has no effect. Use the
+	// PrefixSuffixes of DefaultSyllableRules with WithSyllableRules.
+	PrefixSuffixes = prefixSuffixes
+
+	// DaleChallWordList is the familiar word list for the Dale-Chall
+	// readability scoring algorithm
+	//
+	// Deprecated: changing DaleChallWordList has no effect. Use WithDictionary
+	// or WithFamiliarWords.
+	DaleChallWordList = copySet(daleChallWordList)
 )
 
-// ProblemWords are words that don't follow typical syllable counting rules
-var ProblemWords = map[string]int{
+// problemWords are words that don't follow typical syllable counting rules
+var problemWords = map[string]int{
 	"simile":    3,
 	"forever":   3,
 	"shoreline": 2,
 	"forest":    2,
 }
 
-// SubSyllables are syllables that would be counted as two but should be one
-var SubSyllables = [...]*regexp.Regexp{
+// subSyllables are syllables that would be counted as two but should be one
+var subSyllables = [...]*regexp.Regexp{
 	regexp.MustCompile("cial"),
 	regexp.MustCompile("tia"),
 	regexp.MustCompile("cius"),
@@ -36,8 +66,8 @@ var SubSyllables = [...]*regexp.Regexp{
 	regexp.MustCompile("[aeiouy]rse$"),
 }
 
-// AddSyllables are syllables that would be counted as one but should be two
-var AddSyllables = [...]*regexp.Regexp{
+// addSyllables are syllables that would be counted as one but should be two
+var addSyllables = [...]*regexp.Regexp{
 	regexp.MustCompile("ia"),
 	regexp.MustCompile("riet"),
 	regexp.MustCompile("dien"),
@@ -58,8 +88,8 @@ var AddSyllables = [...]*regexp.Regexp{
 	regexp.MustCompile("yee$"),
 }
 
-// PrefixSuffixes are single syllable prefixes and suffixes
-var PrefixSuffixes = [...]*regexp.Regexp{
+// prefixSuffixes are single syllable prefixes and suffixes
+var prefixSuffixes = [...]*regexp.Regexp{
 	regexp.MustCompile("^un"),
 	regexp.MustCompile("^fore"),
 	regexp.MustCompile("ly$"),
@@ -69,9 +99,9 @@ var PrefixSuffixes = [...]*regexp.Regexp{
 	regexp.MustCompile("ings?$"),
 }
 
-// DaleChallWordList is the familiar word list for the Dale-Chall readability
+// daleChallWordList is the familiar word list for the Dale-Chall readability
 // scoring algorithm
-var DaleChallWordList = map[string]struct{}{
+var daleChallWordList = map[string]struct{}{
 	"a":             struct{}{},
 	"able":          struct{}{},
 	"aboard":        struct{}{},
@@ -0,0 +1,158 @@
+package textstats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Dictionary adds to and removes from the built in word lists for an
+// analysis, without changing them for any other analysis. Words are stored in
+// lower case.
+//
+// A Dictionary must not be changed while it is being used by Analyse.
+type Dictionary struct {
+	problemWords  map[string]int
+	familiarWords map[string]struct{}
+	removedWords  map[string]struct{}
+}
+
+// NewDictionary returns an empty Dictionary
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		problemWords:  make(map[string]int),
+		familiarWords: make(map[string]struct{}),
+		removedWords:  make(map[string]struct{}),
+	}
+}
+
+// LoadDictionary reads a Dictionary from r. See Dictionary.Load for the
+// format.
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	d := NewDictionary()
+	if err := d.Load(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadDictionaryFile reads a Dictionary from the named file. See
+// Dictionary.Load for the format.
+func LoadDictionaryFile(name string) (*Dictionary, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadDictionary(f)
+}
+
+// AddProblemWord sets the number of syllables in a word, overriding the
+// syllable counting rules
+func (d *Dictionary) AddProblemWord(word string, syllables int) {
+	word = strings.ToLower(word)
+	delete(d.removedWords, word)
+	d.problemWords[word] = syllables
+}
+
+// AddFamiliarWord adds words to the familiar word list used to find difficult
+// words for the Dale-Chall readability score
+func (d *Dictionary) AddFamiliarWord(words ...string) {
+	for _, word := range words {
+		word = strings.ToLower(word)
+		delete(d.removedWords, word)
+		d.familiarWords[word] = struct{}{}
+	}
+}
+
+// RemoveWord removes words from both the problem and familiar word lists,
+// including the built in ones
+func (d *Dictionary) RemoveWord(words ...string) {
+	for _, word := range words {
+		word = strings.ToLower(word)
+		delete(d.problemWords, word)
+		delete(d.familiarWords, word)
+		d.removedWords[word] = struct{}{}
+	}
+}
+
+// Load adds the entries read from r to the Dictionary. Each line holds one
+// entry, with blank lines and anything following a # ignored:
+//
+//	syllables kubernetes 4
+//	familiar kubectl helm
+//	remove simile
+func (d *Dictionary) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "syllables":
+			if len(fields) != 3 {
+				return fmt.Errorf("textstats: dictionary line %d: syllables needs a word and a count", line)
+			}
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return fmt.Errorf("textstats: dictionary line %d: invalid syllable count %q", line, fields[2])
+			}
+			d.AddProblemWord(fields[1], count)
+		case "familiar":
+			d.AddFamiliarWord(fields[1:]...)
+		case "remove":
+			d.RemoveWord(fields[1:]...)
+		default:
+			return fmt.Errorf("textstats: dictionary line %d: unknown entry %q", line, fields[0])
+		}
+	}
+
+	return scanner.Err()
+}
+
+// syllables returns the number of syllables in a lower case word if the
+// Dictionary overrides it. removed is true if the word should not be treated
+// as a problem word at all.
+func (d *Dictionary) syllables(word []byte) (count int, ok, removed bool) {
+	if _, removed = d.removedWords[string(word)]; removed {
+		return 0, false, true
+	}
+	count, ok = d.problemWords[string(word)]
+	return count, ok, false
+}
+
+// familiar reports whether the Dictionary overrides the familiarity of a word
+func (d *Dictionary) familiar(word []byte) (familiar, ok bool) {
+	if _, removed := d.removedWords[string(word)]; removed {
+		return false, true
+	}
+	_, familiar = d.familiarWords[string(word)]
+	return familiar, familiar
+}
+
+func copyCounts(m map[string]int) map[string]int {
+	c := make(map[string]int, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func copySet(m map[string]struct{}) map[string]struct{} {
+	c := make(map[string]struct{}, len(m))
+	for k := range m {
+		c[k] = struct{}{}
+	}
+	return c
+}
@@ -0,0 +1,94 @@
+package textstats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DictionarySuite struct {
+	suite.Suite
+}
+
+const glossary = `
+# team glossary
+syllables kubernetes 4
+familiar kubectl helm   # tools
+remove simile
+`
+
+func (s *DictionarySuite) TestLoad() {
+	d, err := LoadDictionary(strings.NewReader(glossary))
+	s.NoError(err)
+
+	res, _ := Analyse(strings.NewReader("kubernetes kubectl helm simile"), WithDictionary(d))
+	s.Equal(4, res.Words)
+	s.Equal(4+3+1+2, res.Syllables)
+	s.Equal(2, res.DifficultWords)
+
+	res, _ = Analyse(strings.NewReader("kubernetes kubectl helm simile"))
+	s.Equal(4, res.DifficultWords)
+	s.Equal(3, SyllableCount("simile"))
+}
+
+func (s *DictionarySuite) TestLoadErrors() {
+	for _, text := range []string{
+		"syllables word",
+		"syllables word many",
+		"syllables word -1",
+		"unknown word",
+	} {
+		_, err := LoadDictionary(strings.NewReader(text))
+		s.Error(err, text)
+	}
+}
+
+func (s *DictionarySuite) TestLoadFile() {
+	name := filepath.Join(s.T().TempDir(), "glossary.txt")
+	s.NoError(os.WriteFile(name, []byte(glossary), 0644))
+
+	d, err := LoadDictionaryFile(name)
+	s.NoError(err)
+
+	res, _ := Analyse(strings.NewReader("kubernetes"), WithDictionary(d))
+	s.Equal(4, res.Syllables)
+
+	_, err = LoadDictionaryFile(filepath.Join(s.T().TempDir(), "missing.txt"))
+	s.Error(err)
+}
+
+func (s *DictionarySuite) TestOverrides() {
+	d := NewDictionary()
+	d.RemoveWord("dog")
+	d.AddProblemWord("Fox", 2)
+	d.AddFamiliarWord("lazy")
+	d.RemoveWord("lazy")
+
+	res, _ := Analyse(strings.NewReader("quick fox lazy dog"), WithDictionary(d))
+	s.Equal(6, res.Syllables)
+	s.Equal(2, res.DifficultWords)
+
+	d.AddFamiliarWord("lazy")
+	res, _ = Analyse(strings.NewReader("quick fox lazy dog"), WithDictionary(d))
+	s.Equal(1, res.DifficultWords)
+}
+
+func (s *DictionarySuite) TestGlobalsAreCopies() {
+	ProblemWords["fox"] = 7
+	delete(DaleChallWordList, "dog")
+	defer func() {
+		delete(ProblemWords, "fox")
+		DaleChallWordList["dog"] = struct{}{}
+	}()
+
+	res, _ := Analyse(strings.NewReader("fox dog"))
+	s.Equal(2, res.Syllables)
+	s.Equal(0, res.DifficultWords)
+}
+
+func TestDictionary(t *testing.T) {
+	suite.Run(t, new(DictionarySuite))
+}
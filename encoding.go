@@ -0,0 +1,551 @@
+package textstats
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ResultsVersion is the version of the schema Results are encoded with. It
+// changes whenever the schema changes in a way older versions can't decode.
+const ResultsVersion = 1
+
+// resultsSchema is how Results are encoded. The averages and scores are
+// calculated from the counts, so they are ignored when decoding.
+type resultsSchema struct {
+	Version    int               `json:"version" yaml:"version"`
+	Counts     countsSchema      `json:"counts" yaml:"counts"`
+	Averages   averagesSchema    `json:"averages" yaml:"averages"`
+	Scores     ScoreSet          `json:"scores" yaml:"scores"`
+	Histograms histogramsSchema  `json:"histograms" yaml:"histograms"`
+	Words      []WordResult      `json:"words,omitempty" yaml:"words,omitempty"`
+	Sentences  []SentenceResult  `json:"sentences,omitempty" yaml:"sentences,omitempty"`
+	Paragraphs []ParagraphResult `json:"paragraphs,omitempty" yaml:"paragraphs,omitempty"`
+}
+
+type countsSchema struct {
+	Words          int `json:"words" yaml:"words"`
+	Sentences      int `json:"sentences" yaml:"sentences"`
+	Paragraphs     int `json:"paragraphs" yaml:"paragraphs"`
+	Letters        int `json:"letters" yaml:"letters"`
+	Punctuation    int `json:"punctuation" yaml:"punctuation"`
+	Spaces         int `json:"spaces" yaml:"spaces"`
+	Syllables      int `json:"syllables" yaml:"syllables"`
+	DifficultWords int `json:"difficult_words" yaml:"difficult_words"`
+	ComplexWords   int `json:"complex_words" yaml:"complex_words"`
+	Numbers        int `json:"numbers" yaml:"numbers"`
+	URLs           int `json:"urls" yaml:"urls"`
+	Emails         int `json:"emails" yaml:"emails"`
+	Mentions       int `json:"mentions" yaml:"mentions"`
+	Hashtags       int `json:"hashtags" yaml:"hashtags"`
+	Acronyms       int `json:"acronyms" yaml:"acronyms"`
+	Bytes          int `json:"bytes" yaml:"bytes"`
+}
+
+type averagesSchema struct {
+	LettersPerWord        float64 `json:"letters_per_word" yaml:"letters_per_word"`
+	SyllablesPerWord      float64 `json:"syllables_per_word" yaml:"syllables_per_word"`
+	WordsPerSentence      float64 `json:"words_per_sentence" yaml:"words_per_sentence"`
+	SentencesPerParagraph float64 `json:"sentences_per_paragraph" yaml:"sentences_per_paragraph"`
+	WordsPerParagraph     float64 `json:"words_per_paragraph" yaml:"words_per_paragraph"`
+}
+
+type histogramsSchema struct {
+	SyllablesPerWord    map[int]int `json:"syllables_per_word" yaml:"syllables_per_word"`
+	ProperNounSyllables map[int]int `json:"proper_noun_syllables" yaml:"proper_noun_syllables"`
+	LettersPerWord      map[int]int `json:"letters_per_word" yaml:"letters_per_word"`
+	WordsPerSentence    map[int]int `json:"words_per_sentence" yaml:"words_per_sentence"`
+}
+
+// detailSchema is how a SentenceResult or ParagraphResult is encoded. JSON
+// strings can't hold invalid UTF-8, so text that isn't valid UTF-8 is base64
+// encoded in TextBase64 instead of Text.
+type detailSchema struct {
+	Start      int      `json:"start" yaml:"start"`
+	End        int      `json:"end" yaml:"end"`
+	Text       string   `json:"text" yaml:"text"`
+	TextBase64 string   `json:"text_base64,omitempty" yaml:"text_base64,omitempty"`
+	Results    *Results `json:"results" yaml:"results"`
+}
+
+func newDetailSchema(start, end int, text string, res *Results) detailSchema {
+	d := detailSchema{Start: start, End: end, Results: res}
+	if utf8.ValidString(text) {
+		d.Text = text
+	} else {
+		d.TextBase64 = base64.StdEncoding.EncodeToString([]byte(text))
+	}
+	return d
+}
+
+func (r *Results) schema() *resultsSchema {
+	// Word details are only written at the top level, as the details hold
+	// the same words
+	details := r.withoutWords()
+
+	return &resultsSchema{
+		Version: ResultsVersion,
+		Counts: countsSchema{
+			Words:          r.Words,
+			Sentences:      r.Sentences,
+			Paragraphs:     r.Paragraphs,
+			Letters:        r.Letters,
+			Punctuation:    r.Punctuation,
+			Spaces:         r.Spaces,
+			Syllables:      r.Syllables,
+			DifficultWords: r.DifficultWords,
+			ComplexWords:   r.ComplexWords,
+			Numbers:        r.Numbers,
+			URLs:           r.URLs,
+			Emails:         r.Emails,
+			Mentions:       r.Mentions,
+			Hashtags:       r.Hashtags,
+			Acronyms:       r.Acronyms,
+			Bytes:          r.Bytes,
+		},
+		Averages: averagesSchema{
+			LettersPerWord:        r.AverageLettersPerWord(),
+			SyllablesPerWord:      r.AverageSyllablesPerWord(),
+			WordsPerSentence:      r.AverageWordsPerSentence(),
+			SentencesPerParagraph: r.AverageSentencesPerParagraph(),
+			WordsPerParagraph:     r.AverageWordsPerParagraph(),
+		},
+		Scores: r.AllScores(),
+		Histograms: histogramsSchema{
+			SyllablesPerWord:    histogram(r.syllableWords),
+			ProperNounSyllables: histogram(r.syllableProperNouns),
+			LettersPerWord:      histogram(r.letterWords),
+			WordsPerSentence:    histogram(r.sentenceWords),
+		},
+		Words:      r.WordDetails,
+		Sentences:  details.SentenceDetails,
+		Paragraphs: details.ParagraphDetails,
+	}
+}
+
+// histogram returns counts, or an empty map if it is nil, so that it is
+// always encoded as a map
+func histogram(counts map[int]int) map[int]int {
+	if counts == nil {
+		return map[int]int{}
+	}
+	return counts
+}
+
+func (r *Results) fromSchema(s *resultsSchema) error {
+	if s.Version != ResultsVersion {
+		return fmt.Errorf("textstats: can't decode results version %d", s.Version)
+	}
+
+	*r = Results{
+		Words:            s.Counts.Words,
+		Sentences:        s.Counts.Sentences,
+		Paragraphs:       s.Counts.Paragraphs,
+		Letters:          s.Counts.Letters,
+		Punctuation:      s.Counts.Punctuation,
+		Spaces:           s.Counts.Spaces,
+		Syllables:        s.Counts.Syllables,
+		DifficultWords:   s.Counts.DifficultWords,
+		ComplexWords:     s.Counts.ComplexWords,
+		Numbers:          s.Counts.Numbers,
+		URLs:             s.Counts.URLs,
+		Emails:           s.Counts.Emails,
+		Mentions:         s.Counts.Mentions,
+		Hashtags:         s.Counts.Hashtags,
+		Acronyms:         s.Counts.Acronyms,
+		Bytes:            s.Counts.Bytes,
+		WordDetails:      s.Words,
+		SentenceDetails:  s.Sentences,
+		ParagraphDetails: s.Paragraphs,
+
+		syllableWords:       s.Histograms.SyllablesPerWord,
+		syllableProperNouns: s.Histograms.ProperNounSyllables,
+		letterWords:         s.Histograms.LettersPerWord,
+		sentenceWords:       s.Histograms.WordsPerSentence,
+	}
+	r.init()
+
+	if len(r.WordDetails) > 0 {
+		r.spreadWords()
+	}
+
+	return nil
+}
+
+// withoutWords returns a copy of r without word details at any level
+func (r Results) withoutWords() Results {
+	r.WordDetails = nil
+	if r.SentenceDetails != nil {
+		sentences := make([]SentenceResult, len(r.SentenceDetails))
+		for i, sentence := range r.SentenceDetails {
+			sentence.Results = sentence.Results.withoutWords()
+			sentences[i] = sentence
+		}
+		r.SentenceDetails = sentences
+	}
+	if r.ParagraphDetails != nil {
+		paragraphs := make([]ParagraphResult, len(r.ParagraphDetails))
+		for i, paragraph := range r.ParagraphDetails {
+			paragraph.Results = paragraph.Results.withoutWords()
+			paragraphs[i] = paragraph
+		}
+		r.ParagraphDetails = paragraphs
+	}
+	return r
+}
+
+// spreadWords gives each sentence and paragraph detail the word details
+// within it, at every level
+func (r *Results) spreadWords() {
+	for i := range r.SentenceDetails {
+		sentence := &r.SentenceDetails[i]
+		sentence.WordDetails = wordsWithin(r.WordDetails, sentence.Start, sentence.End)
+		sentence.spreadWords()
+	}
+	for i := range r.ParagraphDetails {
+		paragraph := &r.ParagraphDetails[i]
+		paragraph.WordDetails = wordsWithin(r.WordDetails, paragraph.Start, paragraph.End)
+		paragraph.spreadWords()
+	}
+}
+
+// wordsWithin returns the words with offsets from start up to end, which must
+// be in offset order
+func wordsWithin(words []WordResult, start, end int) []WordResult {
+	lo := sort.Search(len(words), func(i int) bool { return words[i].Offset >= start })
+	hi := lo + sort.Search(len(words)-lo, func(i int) bool { return words[lo+i].Offset >= end })
+	if lo == hi {
+		return nil
+	}
+	return words[lo:hi:hi]
+}
+
+func (d *detailSchema) text() (string, error) {
+	if d.TextBase64 == "" {
+		return d.Text, nil
+	}
+	text, err := base64.StdEncoding.DecodeString(d.TextBase64)
+	if err != nil {
+		return "", fmt.Errorf("textstats: detail at %d has invalid text_base64", d.Start)
+	}
+	return string(text), nil
+}
+
+func (d *detailSchema) results() (Results, error) {
+	if d.Results == nil {
+		return Results{}, fmt.Errorf("textstats: detail at %d has no results", d.Start)
+	}
+	return *d.Results, nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the counts, averages,
+// scores, histograms and details along with ResultsVersion. Word details are
+// only written at the top level, and are given back to the sentence and
+// paragraph details by their offsets when decoding.
+func (r Results) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.schema())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding Results encoded by
+// MarshalJSON with the same ResultsVersion
+func (r *Results) UnmarshalJSON(data []byte) error {
+	var s resultsSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return r.fromSchema(&s)
+}
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3, with the same schema as MarshalJSON
+func (r Results) MarshalYAML() (interface{}, error) {
+	return r.schema(), nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of gopkg.in/yaml.v2,
+// which gopkg.in/yaml.v3 also supports, decoding Results encoded by
+// MarshalYAML with the same ResultsVersion
+func (r *Results) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s resultsSchema
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return r.fromSchema(&s)
+}
+
+// MarshalJSON implements json.Marshaler
+func (s SentenceResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newDetailSchema(s.Start, s.End, s.Text, &s.Results))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *SentenceResult) UnmarshalJSON(data []byte) error {
+	var d detailSchema
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	text, err := d.text()
+	if err != nil {
+		return err
+	}
+	res, err := d.results()
+	*s = SentenceResult{d.Start, d.End, text, res}
+	return err
+}
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3
+func (s SentenceResult) MarshalYAML() (interface{}, error) {
+	return newDetailSchema(s.Start, s.End, s.Text, &s.Results), nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of gopkg.in/yaml.v2
+func (s *SentenceResult) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d detailSchema
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	text, err := d.text()
+	if err != nil {
+		return err
+	}
+	res, err := d.results()
+	*s = SentenceResult{d.Start, d.End, text, res}
+	return err
+}
+
+// MarshalJSON implements json.Marshaler
+func (p ParagraphResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newDetailSchema(p.Start, p.End, p.Text, &p.Results))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *ParagraphResult) UnmarshalJSON(data []byte) error {
+	var d detailSchema
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	text, err := d.text()
+	if err != nil {
+		return err
+	}
+	res, err := d.results()
+	*p = ParagraphResult{d.Start, d.End, text, res}
+	return err
+}
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3
+func (p ParagraphResult) MarshalYAML() (interface{}, error) {
+	return newDetailSchema(p.Start, p.End, p.Text, &p.Results), nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of gopkg.in/yaml.v2
+func (p *ParagraphResult) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d detailSchema
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	text, err := d.text()
+	if err != nil {
+		return err
+	}
+	res, err := d.results()
+	*p = ParagraphResult{d.Start, d.End, text, res}
+	return err
+}
+
+// csvColumn is a column written by WriteCSV. parse sets the column from a
+// field, reporting whether it was valid, and is nil for the averages and
+// scores, which are calculated from the counts.
+type csvColumn struct {
+	name  string
+	value func(s *resultsSchema) string
+	parse func(s *resultsSchema, field string) bool
+}
+
+func countColumn(name string, count func(s *resultsSchema) *int) csvColumn {
+	return csvColumn{
+		name:  name,
+		value: func(s *resultsSchema) string { return strconv.Itoa(*count(s)) },
+		parse: func(s *resultsSchema, field string) bool {
+			n, err := strconv.Atoi(field)
+			*count(s) = n
+			return err == nil
+		},
+	}
+}
+
+func floatColumn(name string, value func(s *resultsSchema) float64) csvColumn {
+	return csvColumn{
+		name:  name,
+		value: func(s *resultsSchema) string { return formatFloat(value(s)) },
+	}
+}
+
+func histogramColumn(name string, counts func(s *resultsSchema) *map[int]int) csvColumn {
+	return csvColumn{
+		name:  name,
+		value: func(s *resultsSchema) string { return formatHistogram(*counts(s)) },
+		parse: func(s *resultsSchema, field string) (ok bool) {
+			*counts(s), ok = parseHistogram(field)
+			return ok
+		},
+	}
+}
+
+// csvColumns are the columns written by WriteCSV, named as in the JSON
+// schema, with the averages and histograms named by their group
+var csvColumns = []csvColumn{
+	countColumn("version", func(s *resultsSchema) *int { return &s.Version }),
+	countColumn("words", func(s *resultsSchema) *int { return &s.Counts.Words }),
+	countColumn("sentences", func(s *resultsSchema) *int { return &s.Counts.Sentences }),
+	countColumn("paragraphs", func(s *resultsSchema) *int { return &s.Counts.Paragraphs }),
+	countColumn("letters", func(s *resultsSchema) *int { return &s.Counts.Letters }),
+	countColumn("punctuation", func(s *resultsSchema) *int { return &s.Counts.Punctuation }),
+	countColumn("spaces", func(s *resultsSchema) *int { return &s.Counts.Spaces }),
+	countColumn("syllables", func(s *resultsSchema) *int { return &s.Counts.Syllables }),
+	countColumn("difficult_words", func(s *resultsSchema) *int { return &s.Counts.DifficultWords }),
+	countColumn("complex_words", func(s *resultsSchema) *int { return &s.Counts.ComplexWords }),
+	countColumn("numbers", func(s *resultsSchema) *int { return &s.Counts.Numbers }),
+	countColumn("urls", func(s *resultsSchema) *int { return &s.Counts.URLs }),
+	countColumn("emails", func(s *resultsSchema) *int { return &s.Counts.Emails }),
+	countColumn("mentions", func(s *resultsSchema) *int { return &s.Counts.Mentions }),
+	countColumn("hashtags", func(s *resultsSchema) *int { return &s.Counts.Hashtags }),
+	countColumn("acronyms", func(s *resultsSchema) *int { return &s.Counts.Acronyms }),
+	countColumn("bytes", func(s *resultsSchema) *int { return &s.Counts.Bytes }),
+	floatColumn("average_letters_per_word", func(s *resultsSchema) float64 { return s.Averages.LettersPerWord }),
+	floatColumn("average_syllables_per_word", func(s *resultsSchema) float64 { return s.Averages.SyllablesPerWord }),
+	floatColumn("average_words_per_sentence", func(s *resultsSchema) float64 { return s.Averages.WordsPerSentence }),
+	floatColumn("average_sentences_per_paragraph", func(s *resultsSchema) float64 { return s.Averages.SentencesPerParagraph }),
+	floatColumn("average_words_per_paragraph", func(s *resultsSchema) float64 { return s.Averages.WordsPerParagraph }),
+	floatColumn("flesch_kincaid_reading_ease", func(s *resultsSchema) float64 { return s.Scores.FleschKincaidReadingEase }),
+	floatColumn("flesch_kincaid_grade_level", func(s *resultsSchema) float64 { return s.Scores.FleschKincaidGradeLevel }),
+	floatColumn("gunning_fog_score", func(s *resultsSchema) float64 { return s.Scores.GunningFogScore }),
+	floatColumn("coleman_liau_index", func(s *resultsSchema) float64 { return s.Scores.ColemanLiauIndex }),
+	floatColumn("smog_index", func(s *resultsSchema) float64 { return s.Scores.SMOGIndex }),
+	floatColumn("automated_readability_index", func(s *resultsSchema) float64 { return s.Scores.AutomatedReadabilityIndex }),
+	floatColumn("dale_chall_readability_score", func(s *resultsSchema) float64 { return s.Scores.DaleChallReadabilityScore }),
+	histogramColumn("histogram_syllables_per_word", func(s *resultsSchema) *map[int]int { return &s.Histograms.SyllablesPerWord }),
+	histogramColumn("histogram_proper_noun_syllables", func(s *resultsSchema) *map[int]int { return &s.Histograms.ProperNounSyllables }),
+	histogramColumn("histogram_letters_per_word", func(s *resultsSchema) *map[int]int { return &s.Histograms.LettersPerWord }),
+	histogramColumn("histogram_words_per_sentence", func(s *resultsSchema) *map[int]int { return &s.Histograms.WordsPerSentence }),
+}
+
+// WriteCSV writes a header and then a row for each of the results, with their
+// counts, averages, scores and histograms. A histogram is written as
+// space separated value:count pairs, such as "1:12 2:5 3:1". The details
+// aren't written.
+func WriteCSV(w io.Writer, results ...*Results) error {
+	cw := csv.NewWriter(w)
+
+	record := make([]string, len(csvColumns))
+	for i, column := range csvColumns {
+		record[i] = column.name
+	}
+	cw.Write(record)
+
+	for _, r := range results {
+		s := r.schema()
+		for i, column := range csvColumns {
+			record[i] = column.value(s)
+		}
+		cw.Write(record)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV reads the rows written by WriteCSV with the same ResultsVersion.
+// The averages and scores are calculated from the counts, so those columns
+// are ignored, and the Results have no details, as WriteCSV doesn't write
+// them.
+func ReadCSV(r io.Reader) ([]*Results, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("textstats: csv has no header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]int, len(header))
+	for i, name := range header {
+		fields[name] = i
+	}
+	for _, column := range csvColumns {
+		if _, ok := fields[column.name]; !ok && column.parse != nil {
+			return nil, fmt.Errorf("textstats: csv has no %s column", column.name)
+		}
+	}
+
+	var results []*Results
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var s resultsSchema
+		for _, column := range csvColumns {
+			if column.parse == nil {
+				continue
+			}
+			if field := record[fields[column.name]]; !column.parse(&s, field) {
+				return nil, fmt.Errorf("textstats: csv row %d: invalid %s %q", row, column.name, field)
+			}
+		}
+
+		res := new(Results)
+		if err := res.fromSchema(&s); err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func formatHistogram(counts map[int]int) string {
+	h := Histogram{counts}
+	pairs := make([]string, 0, len(counts))
+	for _, value := range h.Values() {
+		pairs = append(pairs, strconv.Itoa(value)+":"+strconv.Itoa(h.Count(value)))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// parseHistogram parses a histogram written by formatHistogram
+func parseHistogram(field string) (map[int]int, bool) {
+	counts := make(map[int]int)
+	for _, pair := range strings.Fields(field) {
+		i := strings.IndexByte(pair, ':')
+		if i < 0 {
+			return nil, false
+		}
+		value, err := strconv.Atoi(pair[:i])
+		if err != nil {
+			return nil, false
+		}
+		count, err := strconv.Atoi(pair[i+1:])
+		if err != nil {
+			return nil, false
+		}
+		counts[value] = count
+	}
+	return counts, true
+}
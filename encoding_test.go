@@ -0,0 +1,222 @@
+package textstats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type EncodingSuite struct {
+	suite.Suite
+}
+
+func (s *EncodingSuite) details() *Results {
+	res, _ := Analyse(strings.NewReader(paragraphs), WithWordDetails(), WithSentenceDetails(), WithParagraphDetails())
+	return res
+}
+
+func (s *EncodingSuite) TestJSON() {
+	for _, res := range []*Results{s.details(), newResults(), {}} {
+		data, err := json.Marshal(res)
+		s.Require().NoError(err)
+
+		var got Results
+		s.Require().NoError(json.Unmarshal(data, &got))
+		res.init()
+		s.Equal(res, &got)
+	}
+}
+
+func (s *EncodingSuite) TestWordsOnce() {
+	for _, opts := range [][]Option{
+		{WithWordDetails()},
+		{WithWordDetails(), WithSentenceDetails()},
+		{WithWordDetails(), WithParagraphDetails()},
+		{WithWordDetails(), WithSentenceDetails(), WithParagraphDetails()},
+	} {
+		res, _ := Analyse(strings.NewReader(paragraphs), opts...)
+		data, err := json.Marshal(res)
+		s.Require().NoError(err)
+		s.Equal(res.Words, strings.Count(string(data), `"word":`))
+
+		var got Results
+		s.Require().NoError(json.Unmarshal(data, &got))
+		s.Equal(res, &got)
+	}
+}
+
+func (s *EncodingSuite) TestSchema() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	data, err := json.Marshal(res)
+	s.Require().NoError(err)
+
+	var schema struct {
+		Version                              int
+		Counts, Averages, Scores, Histograms map[string]interface{}
+		Words                                []interface{}
+	}
+	s.Require().NoError(json.Unmarshal(data, &schema))
+	s.Equal(ResultsVersion, schema.Version)
+	s.Equal(9.0, schema.Counts["words"])
+	s.Equal(res.AverageLettersPerWord(), schema.Averages["letters_per_word"])
+	s.Equal(res.DaleChallReadabilityScore(), schema.Scores["dale_chall_readability_score"])
+	s.Equal(map[string]interface{}{"9": 1.0}, schema.Histograms["words_per_sentence"])
+	s.Nil(schema.Words)
+
+	var got Results
+	s.Error(json.Unmarshal([]byte(`{"version":2}`), &got))
+	s.Error(json.Unmarshal([]byte(`{"counts":{"words":1}}`), &got))
+}
+
+func (s *EncodingSuite) TestMergeDecoded() {
+	first, _ := Analyse(strings.NewReader(chapters[0]))
+	data, err := json.Marshal(first)
+	s.Require().NoError(err)
+
+	var decoded Results
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	second, _ := Analyse(strings.NewReader(chapters[1]))
+	decoded.Merge(second)
+
+	want, _ := Analyse(strings.NewReader(chapters[0] + chapters[1]))
+	s.Equal(want.WordsPerSentence(), decoded.WordsPerSentence())
+	s.Equal(want.SMOGIndex(), decoded.SMOGIndex())
+}
+
+func (s *EncodingSuite) TestYAML() {
+	res := s.details()
+	data, err := yaml.Marshal(res)
+	s.Require().NoError(err)
+	s.Contains(string(data), "version: 1\n")
+	s.Contains(string(data), "dale_chall_readability_score:")
+
+	var got Results
+	s.Require().NoError(yaml.Unmarshal(data, &got))
+	s.Equal(res, &got)
+}
+
+func (s *EncodingSuite) TestInvalidUTF8() {
+	res, _ := Analyse(strings.NewReader("Hello\xff world. Bye\xfe.\n\nAgain."), WithWordDetails(), WithSentenceDetails(), WithParagraphDetails())
+	s.Equal("Hello\xff world.", res.SentenceDetails[0].Text)
+
+	data, err := json.Marshal(res)
+	s.Require().NoError(err)
+	var got Results
+	s.Require().NoError(json.Unmarshal(data, &got))
+	s.Equal(res, &got)
+
+	data, err = yaml.Marshal(res)
+	s.Require().NoError(err)
+	got = Results{}
+	s.Require().NoError(yaml.Unmarshal(data, &got))
+	s.Equal(res, &got)
+
+	// valid text is still written as it is
+	data, err = json.Marshal(res.SentenceDetails[2])
+	s.Require().NoError(err)
+	s.Contains(string(data), `"text":"Again."`)
+	s.NotContains(string(data), "text_base64")
+
+	var sentence SentenceResult
+	s.Error(json.Unmarshal([]byte(`{"text_base64":"!","results":{"version":1}}`), &sentence))
+}
+
+func (s *EncodingSuite) TestCSV() {
+	one, _ := Analyse(strings.NewReader(qbf))
+	two, _ := Analyse(strings.NewReader(lorem))
+
+	var buf bytes.Buffer
+	s.Require().NoError(WriteCSV(&buf, one, two))
+
+	records, err := csv.NewReader(bytes.NewReader(buf.Bytes())).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 3)
+
+	row := make(map[string]string)
+	for i, name := range records[0] {
+		row[name] = records[1][i]
+	}
+	s.Equal("1", row["version"])
+	s.Equal("9", row["words"])
+	s.Equal("5.837344444444444", row["dale_chall_readability_score"])
+	s.Equal("9:1", row["histogram_words_per_sentence"])
+	s.Equal(records[2][1], "69")
+
+	got, err := ReadCSV(&buf)
+	s.Require().NoError(err)
+	s.Equal([]*Results{one, two}, got)
+}
+
+func (s *EncodingSuite) TestReadCSV() {
+	got, err := ReadCSV(strings.NewReader(""))
+	s.Error(err)
+	s.Nil(got)
+
+	var buf bytes.Buffer
+	s.Require().NoError(WriteCSV(&buf))
+	got, err = ReadCSV(&buf)
+	s.NoError(err)
+	s.Empty(got)
+
+	// the calculated columns aren't needed, the order doesn't matter and
+	// other columns are ignored
+	want, _ := Analyse(strings.NewReader(qbf))
+	buf.Reset()
+	s.Require().NoError(WriteCSV(&buf, want))
+	records, err := csv.NewReader(&buf).ReadAll()
+	s.Require().NoError(err)
+
+	values := make(map[string]string)
+	for i, name := range records[0] {
+		values[name] = records[1][i]
+	}
+	header, row := []string{"source"}, []string{"qbf.txt"}
+	for _, column := range csvColumns {
+		if column.parse != nil {
+			header = append([]string{column.name}, header...)
+			row = append([]string{values[column.name]}, row...)
+		}
+	}
+
+	var text strings.Builder
+	w := csv.NewWriter(&text)
+	w.Write(header)
+	w.Write(row)
+	w.Flush()
+	got, err = ReadCSV(strings.NewReader(text.String()))
+	s.Require().NoError(err)
+	s.Equal([]*Results{want}, got)
+
+	for _, invalid := range []struct{ column, value string }{
+		{"version", "2"},
+		{"words", "nine"},
+		{"histogram_words_per_sentence", "9"},
+		{"histogram_words_per_sentence", "9:x"},
+	} {
+		text.Reset()
+		w := csv.NewWriter(&text)
+		w.Write(records[0])
+		row = append([]string(nil), records[1]...)
+		for i, name := range records[0] {
+			if name == invalid.column {
+				row[i] = invalid.value
+			}
+		}
+		w.Write(row)
+		w.Flush()
+		_, err = ReadCSV(strings.NewReader(text.String()))
+		s.Error(err, invalid.column)
+	}
+
+	_, err = ReadCSV(strings.NewReader("version,words\n1,9\n"))
+	s.Error(err)
+}
+
+func TestEncoding(t *testing.T) {
+	suite.Run(t, new(EncodingSuite))
+}
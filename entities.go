@@ -0,0 +1,107 @@
+package textstats
+
+import (
+	"bytes"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxEntityLength is the longest run of text that will be considered as a
+// single number, URL, email address, mention or hashtag
+const maxEntityLength = 2048
+
+// entityTrailing is punctuation that can follow an entity without being part
+// of it
+const entityTrailing = ".,;:!?\"')]}>…’”"
+
+var (
+	urlPrefixes = [...][]byte{
+		[]byte("http://"),
+		[]byte("https://"),
+		[]byte("ftp://"),
+		[]byte("mailto:"),
+		[]byte("www."),
+	}
+
+	numberRegexp  = regexp.MustCompile(`^[vV]?[0-9]+(?:[.,:/][0-9]+)*[%\p{L}0-9]*`)
+	emailRegexp   = regexp.MustCompile(`^[\p{L}\p{Nd}._%+-]+@[\p{L}\p{Nd}-]+(?:\.[\p{L}\p{Nd}-]+)*\.\p{L}{2,}`)
+	mentionRegexp = regexp.MustCompile(`^@[\p{L}\p{Nd}_]+`)
+	hashtagRegexp = regexp.MustCompile(`^#[\p{L}\p{Nd}_]*\p{L}[\p{L}\p{Nd}_]*`)
+)
+
+// entity returns the length and kind of a number, URL, email address, mention
+// or hashtag at the start of data, or 0 if there isn't one. more is true if
+// there isn't enough data to decide.
+func entity(data []byte, atEOF bool) (n int, kind TokenKind, more bool) {
+	r, size := utf8.DecodeRune(data)
+	next, _ := utf8.DecodeRune(data[size:])
+	isNumber := isDigit(r) || ((r == 'v' || r == 'V') && isDigit(next))
+	if !isNumber && r != '@' && r != '#' && !unicode.IsLetter(r) {
+		return 0, OtherToken, false
+	}
+
+	// Find the end of the run of text up to the next space
+	window := data
+	if len(window) > maxEntityLength {
+		window = window[:maxEntityLength]
+	}
+	end := bytes.IndexFunc(window, unicode.IsSpace)
+	switch {
+	case end < 0 && len(data) >= maxEntityLength:
+		end = maxEntityLength
+	case end < 0 && !atEOF:
+		return 0, OtherToken, true
+	case end < 0:
+		end = len(data)
+	}
+	run := bytes.TrimRight(data[:end], entityTrailing)
+
+	switch {
+	case isNumber:
+		return len(numberRegexp.Find(run)), NumberToken, false
+	case r == '@':
+		return len(mentionRegexp.Find(run)), MentionToken, false
+	case r == '#':
+		return len(hashtagRegexp.Find(run)), HashtagToken, false
+	}
+
+	for _, prefix := range urlPrefixes {
+		if len(run) > len(prefix) && bytes.EqualFold(run[:len(prefix)], prefix) {
+			return len(run), URLToken, false
+		}
+	}
+
+	if bytes.IndexByte(run, '@') > 0 {
+		if n := len(emailRegexp.Find(run)); n > 0 {
+			return n, EmailToken, false
+		}
+	}
+
+	return 0, OtherToken, false
+}
+
+// TokenRule returns the words that a number, URL, email address, mention or
+// hashtag is counted as. If it returns no words, the token is only counted in
+// its own total in Results.
+type TokenRule func(text string) []string
+
+// SkipToken is a TokenRule that doesn't count a token as any words
+func SkipToken(text string) []string {
+	return nil
+}
+
+// SingleWord is a TokenRule that counts a token as a single word made from
+// the letters within it
+func SingleWord(text string) []string {
+	return []string{text}
+}
+
+// defaultTokenRules are the TokenRules used unless changed with WithTokenRule
+var defaultTokenRules = map[TokenKind]TokenRule{
+	NumberToken:  SkipToken,
+	URLToken:     SkipToken,
+	EmailToken:   SkipToken,
+	MentionToken: SingleWord,
+	HashtagToken: SingleWord,
+}
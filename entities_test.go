@@ -0,0 +1,112 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EntitiesSuite struct {
+	suite.Suite
+}
+
+const entities = "In 2024 see http://x.com/a?b=c, mail me@example.org or ping @gopher #golang v1.2.3 now."
+
+func (s *EntitiesSuite) TestTokenize() {
+	s.Equal([]token{
+		{WordToken, "In"},
+		{SpaceToken, " "},
+		{NumberToken, "2024"},
+		{SpaceToken, " "},
+		{WordToken, "see"},
+		{SpaceToken, " "},
+		{URLToken, "http://x.com/a?b=c"},
+		{PunctToken, ","},
+		{SpaceToken, " "},
+		{WordToken, "mail"},
+		{SpaceToken, " "},
+		{EmailToken, "me@example.org"},
+		{SpaceToken, " "},
+		{WordToken, "or"},
+		{SpaceToken, " "},
+		{WordToken, "ping"},
+		{SpaceToken, " "},
+		{MentionToken, "@gopher"},
+		{SpaceToken, " "},
+		{HashtagToken, "#golang"},
+		{SpaceToken, " "},
+		{NumberToken, "v1.2.3"},
+		{SpaceToken, " "},
+		{WordToken, "now"},
+		{PunctToken, "."},
+	}, tokenize(DefaultTokenizer, entities))
+}
+
+func (s *EntitiesSuite) TestNotEntities() {
+	s.Equal([]token{
+		{PunctToken, "#"},
+		{NumberToken, "1"},
+		{SpaceToken, " "},
+		{WordToken, "very"},
+		{SpaceToken, " "},
+		{WordToken, "a"},
+		{MentionToken, "@b"},
+	}, tokenize(DefaultTokenizer, "#1 very a@b"))
+}
+
+func (s *EntitiesSuite) TestMentionMustStartWord() {
+	res, _ := Analyse(strings.NewReader("a@b (@c) d#e"))
+	s.Equal(1, res.Mentions)
+	s.Equal(0, res.Hashtags)
+	s.Equal(5, res.Words)
+	s.Equal(4, res.Punctuation)
+}
+
+func (s *EntitiesSuite) TestRuleTokenizerEntities() {
+	s.Equal([]token{
+		{URLToken, "www.example.com"},
+		{SpaceToken, " "},
+		{WordToken, "ok"},
+	}, tokenize(&RuleTokenizer{Entities: true}, "www.example.com ok"))
+}
+
+func (s *EntitiesSuite) TestNeedsMoreData() {
+	n, _, _ := DefaultTokenizer.Tokenize([]byte("http://exam"), false)
+	s.Equal(0, n)
+
+	n, kind, _ := DefaultTokenizer.Tokenize([]byte("http://example.com. "), false)
+	s.Equal(18, n)
+	s.Equal(URLToken, kind)
+}
+
+func (s *EntitiesSuite) TestCounts() {
+	res, _ := Analyse(strings.NewReader(entities))
+	s.Equal(2, res.Numbers)
+	s.Equal(1, res.URLs)
+	s.Equal(1, res.Emails)
+	s.Equal(1, res.Mentions)
+	s.Equal(1, res.Hashtags)
+	s.Equal(8, res.Words)
+	s.Equal(1, res.Sentences)
+	s.Equal(2, res.Punctuation)
+}
+
+func (s *EntitiesSuite) TestTokenRules() {
+	res, _ := Analyse(strings.NewReader("Born in 1999."), WithTokenRule(NumberToken, SpellNumber))
+	s.Equal(1, res.Numbers)
+	s.Equal(5, res.Words)
+	s.Equal(1, res.Punctuation)
+	s.Equal(SyllableCount("Born in nineteen ninety-nine"), res.Syllables)
+
+	res, _ = Analyse(strings.NewReader("Born in 1999."), WithTokenRule(NumberToken, SingleWord))
+	s.Equal(2, res.Words)
+
+	res, _ = Analyse(strings.NewReader("Hi @gopher"), WithTokenRule(MentionToken, SkipToken))
+	s.Equal(1, res.Words)
+	s.Equal(1, res.Mentions)
+}
+
+func TestEntities(t *testing.T) {
+	suite.Run(t, new(EntitiesSuite))
+}
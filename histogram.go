@@ -0,0 +1,134 @@
+package textstats
+
+import (
+	"math"
+	"sort"
+)
+
+// Histogram counts how many times each value was seen, such as how many words
+// have each number of syllables. It is read only, and shows the Results it
+// came from as they are now.
+type Histogram struct {
+	counts map[int]int
+}
+
+// Count returns the number of times a value was seen
+func (h Histogram) Count(value int) int {
+	return h.counts[value]
+}
+
+// Total returns the number of values seen
+func (h Histogram) Total() int {
+	var total int
+	for _, count := range h.counts {
+		total += count
+	}
+	return total
+}
+
+// Values returns the distinct values seen, in ascending order
+func (h Histogram) Values() []int {
+	values := make([]int, 0, len(h.counts))
+	for value, count := range h.counts {
+		if count > 0 {
+			values = append(values, value)
+		}
+	}
+	sort.Ints(values)
+	return values
+}
+
+// Max returns the largest value seen, or 0 if there are none
+func (h Histogram) Max() int {
+	values := h.Values()
+	if len(values) == 0 {
+		return 0
+	}
+	return values[len(values)-1]
+}
+
+// Mean returns the mean of the values seen, or 0 if there are none
+func (h Histogram) Mean() float64 {
+	var sum, total int
+	for value, count := range h.counts {
+		sum += value * count
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(sum) / float64(total)
+}
+
+// Median returns the middle of the values seen, or 0 if there are none
+func (h Histogram) Median() float64 {
+	return h.Percentile(50)
+}
+
+// Percentile returns the value below which p percent of the values seen fall,
+// interpolating between the two nearest values, or 0 if there are none
+func (h Histogram) Percentile(p float64) float64 {
+	values := h.Values()
+	total := h.Total()
+	if total == 0 {
+		return 0
+	}
+
+	switch {
+	case p < 0:
+		p = 0
+	case p > 100:
+		p = 100
+	}
+
+	rank := p / 100 * float64(total-1)
+	lower := h.nth(values, int(math.Floor(rank)))
+	upper := h.nth(values, int(math.Ceil(rank)))
+
+	return float64(lower) + (float64(upper-lower) * (rank - math.Floor(rank)))
+}
+
+// nth returns the nth smallest value seen, counting from 0
+func (h Histogram) nth(values []int, n int) int {
+	for _, value := range values {
+		n -= h.counts[value]
+		if n < 0 {
+			return value
+		}
+	}
+	return values[len(values)-1]
+}
+
+// StdDev returns the standard deviation of the values seen, or 0 if there are
+// none
+func (h Histogram) StdDev() float64 {
+	mean := h.Mean()
+
+	var sum float64
+	var total int
+	for value, count := range h.counts {
+		diff := float64(value) - mean
+		sum += diff * diff * float64(count)
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return math.Sqrt(sum / float64(total))
+}
+
+// SyllablesPerWord returns the number of words with each syllable count
+func (r *Results) SyllablesPerWord() Histogram {
+	return Histogram{r.syllableWords}
+}
+
+// LettersPerWord returns the number of words with each letter count
+func (r *Results) LettersPerWord() Histogram {
+	return Histogram{r.letterWords}
+}
+
+// WordsPerSentence returns the number of sentences with each word count
+func (r *Results) WordsPerSentence() Histogram {
+	return Histogram{r.sentenceWords}
+}
@@ -0,0 +1,70 @@
+package textstats
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HistogramSuite struct {
+	suite.Suite
+}
+
+func (s *HistogramSuite) TestStatistics() {
+	h := Histogram{map[int]int{1: 2, 2: 1, 4: 1, 9: 0}}
+	s.Equal(2, h.Count(1))
+	s.Equal(0, h.Count(3))
+	s.Equal(4, h.Total())
+	s.Equal([]int{1, 2, 4}, h.Values())
+	s.Equal(4, h.Max())
+	s.Equal(2.0, h.Mean())
+	s.Equal(1.5, h.Median())
+	s.Equal(1.0, h.Percentile(0))
+	s.Equal(1.0, h.Percentile(25))
+	s.Equal(2.5, h.Percentile(75))
+	s.Equal(4.0, h.Percentile(100))
+	s.Equal(4.0, h.Percentile(150))
+	s.InDelta(math.Sqrt(1.5), h.StdDev(), 1e-9)
+
+	var empty Histogram
+	s.Equal(0, empty.Total())
+	s.Empty(empty.Values())
+	s.Equal(0, empty.Max())
+	s.Equal(0.0, empty.Mean())
+	s.Equal(0.0, empty.Median())
+	s.Equal(0.0, empty.StdDev())
+}
+
+func (s *HistogramSuite) TestResults() {
+	res, _ := Analyse(strings.NewReader("The cat sat. A very big dog barked loudly! Hello"))
+
+	words := res.WordsPerSentence()
+	s.Equal(res.Sentences, words.Total())
+	s.Equal([]int{1, 3, 6}, words.Values())
+	s.Equal(6, words.Max())
+	s.Equal(3.0, words.Median())
+	s.Equal(res.AverageWordsPerSentence(), words.Mean())
+
+	letters := res.LettersPerWord()
+	s.Equal(res.Words, letters.Total())
+	s.Equal(6, letters.Max())
+	s.Equal(res.AverageLettersPerWord(), letters.Mean())
+
+	syllables := res.SyllablesPerWord()
+	s.Equal(res.Words, syllables.Total())
+	s.Equal(res.AverageSyllablesPerWord(), syllables.Mean())
+	s.Equal(res.WordsWithAtLeastNSyllables(2, true), syllables.Total()-syllables.Count(1))
+}
+
+func (s *HistogramSuite) TestDetails() {
+	res, _ := Analyse(strings.NewReader("One two. Three four five.\n\nSix."), WithSentenceDetails(), WithParagraphDetails())
+	s.Equal(map[int]int{1: 1, 2: 1, 3: 1}, res.sentenceWords)
+	s.Equal(1, res.SentenceDetails[1].WordsPerSentence().Count(3))
+	s.Equal(2, res.ParagraphDetails[0].WordsPerSentence().Total())
+}
+
+func TestHistograms(t *testing.T) {
+	suite.Run(t, new(HistogramSuite))
+}
@@ -0,0 +1,74 @@
+package textstats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HyphenMode controls how hyphenated compounds such as "well-known" are
+// counted
+type HyphenMode int
+
+const (
+	// HyphenSplit counts each part of a compound as a separate word, with the
+	// hyphens counted as punctuation
+	HyphenSplit HyphenMode = iota
+	// HyphenJoin counts a compound as a single word, with its syllables counted
+	// as if it were written without the hyphens
+	HyphenJoin
+	// HyphenCompound counts a compound as a single word, with its syllables
+	// counted separately for each part
+	HyphenCompound
+)
+
+// wordPart is a run of letters in a word token, found by wordParts
+type wordPart struct {
+	// start and end are the span of the letters in the buffer wordParts
+	// appends to, and offset is their byte offset in the token
+	start, end, offset int
+	// apostrophe is true if an apostrophe was dropped from the part, as in
+	// contractions and possessives such as "you'll" and "dog's"
+	apostrophe bool
+}
+
+// wordParts appends the letters of a word token to buf, split into parts at
+// any hyphens between them, returning the parts and the number of hyphens
+// that split it. Hyphens at the end of a line are removed, as they only split
+// a word across two lines.
+func wordParts(text, buf []byte, parts []wordPart) ([]byte, []wordPart, int) {
+	var hyphens int
+	start := -1
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		i += size
+
+		switch {
+		case unicode.IsLetter(r):
+			if start < 0 {
+				start = len(buf)
+				parts = append(parts, wordPart{start: start, offset: i - size})
+			}
+			buf = append(buf, text[i-size:i]...)
+		case strings.ContainsRune(Apostrophes, r):
+			if start >= 0 {
+				parts[len(parts)-1].apostrophe = true
+			}
+		case strings.ContainsRune(Hyphens, r):
+			if next, _ := utf8.DecodeRune(text[i:]); unicode.IsSpace(next) {
+				continue
+			}
+			if start >= 0 {
+				parts[len(parts)-1].end = len(buf)
+				start = -1
+			}
+			hyphens++
+		}
+	}
+
+	if start >= 0 {
+		parts[len(parts)-1].end = len(buf)
+	}
+
+	return buf, parts, hyphens
+}
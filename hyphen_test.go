@@ -0,0 +1,81 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HyphenSuite struct {
+	suite.Suite
+}
+
+const compounds = "A well-known state-of-the-art e-mail."
+
+func (s *HyphenSuite) TestSplit() {
+	res, _ := Analyse(strings.NewReader(compounds))
+	s.Equal(9, res.Words)
+	s.Equal(9, res.Syllables)
+	s.Equal(28, res.Letters)
+	s.Equal(6, res.Punctuation)
+}
+
+func (s *HyphenSuite) TestJoin() {
+	res, _ := Analyse(strings.NewReader(compounds), WithHyphenMode(HyphenJoin))
+	s.Equal(4, res.Words)
+	s.Equal(8, res.Syllables)
+	s.Equal(28, res.Letters)
+	s.Equal(1, res.Punctuation)
+	s.Equal(1, res.WordsWithAtLeastNSyllables(3, true))
+}
+
+func (s *HyphenSuite) TestCompound() {
+	res, _ := Analyse(strings.NewReader(compounds), WithHyphenMode(HyphenCompound))
+	s.Equal(4, res.Words)
+	s.Equal(9, res.Syllables)
+	s.Equal(28, res.Letters)
+	s.Equal(1, res.Punctuation)
+	s.Equal(1, res.WordsWithAtLeastNSyllables(4, true))
+}
+
+func (s *HyphenSuite) TestCompoundDifficulty() {
+	res, _ := Analyse(strings.NewReader("well-known"), WithHyphenMode(HyphenCompound))
+	s.Equal(0, res.DifficultWords)
+
+	res, _ = Analyse(strings.NewReader("well-oiled"), WithHyphenMode(HyphenCompound))
+	s.Equal(1, res.DifficultWords)
+}
+
+func (s *HyphenSuite) TestLineEndHyphenation() {
+	for _, text := range []string{"an exam-\nple", "an exam-\r\nple", "an exam- \n  ple"} {
+		res, _ := Analyse(strings.NewReader(text))
+		s.Equal(2, res.Words, text)
+		s.Equal(9, res.Letters, text)
+		s.Equal(4, res.Syllables, text)
+		s.Equal(0, res.Punctuation, text)
+	}
+
+	for _, text := range []string{"an exam- ple", "an exam-\n\nple", "an exam-\nPle"} {
+		res, _ := Analyse(strings.NewReader(text))
+		s.Equal(3, res.Words, text)
+		s.Equal(1, res.Punctuation, text)
+	}
+}
+
+func (s *HyphenSuite) TestSoftHyphen() {
+	res, _ := Analyse(strings.NewReader("an exam\u00adple"))
+	s.Equal(2, res.Words)
+	s.Equal(4, res.Syllables)
+
+	res, _ = Analyse(strings.NewReader("an exam\u00adple"), WithTokenizer(&RuleTokenizer{}))
+	s.Equal(2, res.Words)
+	s.Equal(4, res.Syllables)
+
+	res, _ = Analyse(strings.NewReader("an exam\u00adple"), WithHyphenMode(HyphenCompound))
+	s.Equal(4, res.Syllables)
+}
+
+func TestHyphens(t *testing.T) {
+	suite.Run(t, new(HyphenSuite))
+}
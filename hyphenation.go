@@ -0,0 +1,262 @@
+package textstats
+
+import (
+	"bytes"
+	_ "embed" // for the embedded patterns
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed data/hyph-en-us.tex
+var hyphenEnUSData []byte
+
+var (
+	hyphenatorOnce sync.Once
+	hyphenator     *Hyphenator
+)
+
+// Hyphenator is a SyllableCounter that splits words into syllables using
+// Liang's hyphenation patterns, as used by TeX. Patterns are available for
+// many languages, so unlike the default syllable rules it isn't limited to
+// English.
+//
+// Hyphenation points are a close, but not exact, match for syllable breaks.
+type Hyphenator struct {
+	// patterns maps the letters of each pattern to its values, which sit
+	// between and around the letters
+	patterns map[string][]byte
+	// exceptions maps words to the rune offsets of their breaks
+	exceptions map[string][]int
+	// longest is the most runes in any pattern
+	longest int
+
+	// LeftMin and RightMin are the fewest letters that can be split from the
+	// start and end of a word. Patterns are made to be used with minimums, and
+	// give spurious breaks near the ends of words without them. If zero, 2 is
+	// used.
+	LeftMin, RightMin int
+}
+
+// defaultHyphenMin is the fewest letters split from either end of a word
+// unless set in a Hyphenator
+const defaultHyphenMin = 2
+
+// NewHyphenator returns a Hyphenator using the embedded US English patterns
+func NewHyphenator() *Hyphenator {
+	hyphenatorOnce.Do(func() {
+		var err error
+		hyphenator, err = LoadHyphenator(bytes.NewReader(hyphenEnUSData))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	// The patterns are never changed once loaded, so they can be shared
+	return &Hyphenator{
+		patterns:   hyphenator.patterns,
+		exceptions: hyphenator.exceptions,
+		longest:    hyphenator.longest,
+	}
+}
+
+// LoadHyphenator reads hyphenation patterns in either the TeX format used by
+// hyph-*.tex files, where patterns are given in \patterns{...} and exceptions
+// in \hyphenation{...}, or the plain format used by .pat files, which lists
+// one pattern per line. In the plain format, entries without any digits, such
+// as "ta-ble", are exceptions. Anything following a % is ignored.
+func LoadHyphenator(r io.Reader) (*Hyphenator, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Hyphenator{
+		patterns:   make(map[string][]byte),
+		exceptions: make(map[string][]int),
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.IndexByte(line, '%'); i >= 0 {
+			line = line[:i]
+		}
+		lines = append(lines, line)
+	}
+	text := strings.Join(lines, "\n")
+
+	if !strings.Contains(text, `\`) {
+		for _, entry := range strings.Fields(text) {
+			if err := h.add(entry, strings.ContainsAny(entry, "0123456789")); err != nil {
+				return nil, err
+			}
+		}
+		return h, nil
+	}
+
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '\\')
+		if start < 0 {
+			break
+		}
+		open := strings.IndexByte(text[start:], '{')
+		if open < 0 {
+			break
+		}
+		command := strings.TrimSpace(text[start+1 : start+open])
+		text = text[start+open+1:]
+
+		end := strings.IndexByte(text, '}')
+		if end < 0 {
+			return nil, fmt.Errorf(`textstats: hyphenation: missing "}" after \%s`, command)
+		}
+		group := text[:end]
+		text = text[end+1:]
+
+		if command != "patterns" && command != "hyphenation" {
+			continue
+		}
+		for _, entry := range strings.Fields(group) {
+			if err := h.add(entry, command == "patterns"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return h, nil
+}
+
+// LoadHyphenatorFile reads hyphenation patterns from the named file. See
+// LoadHyphenator for the formats.
+func LoadHyphenatorFile(name string) (*Hyphenator, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadHyphenator(f)
+}
+
+// add adds a pattern such as ".hy3ph" or an exception such as "ta-ble"
+func (h *Hyphenator) add(entry string, pattern bool) error {
+	var letters []rune
+	if !pattern {
+		var breaks []int
+		for _, r := range entry {
+			if r == '-' {
+				breaks = append(breaks, len(letters))
+				continue
+			}
+			letters = append(letters, unicode.ToLower(r))
+		}
+		h.exceptions[string(letters)] = breaks
+		return nil
+	}
+
+	values := []byte{0}
+	for _, r := range entry {
+		if r >= '0' && r <= '9' {
+			values[len(values)-1] = byte(r - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		values = append(values, 0)
+	}
+	if len(letters) == 0 {
+		return fmt.Errorf("textstats: hyphenation: invalid pattern %q", entry)
+	}
+
+	h.patterns[string(letters)] = values
+	if len(letters) > h.longest {
+		h.longest = len(letters)
+	}
+	return nil
+}
+
+// breaks returns the rune offsets in word where it can be split
+func (h *Hyphenator) breaks(word []rune) []int {
+	lower := make([]rune, len(word))
+	for i, r := range word {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	if breaks, ok := h.exceptions[string(lower)]; ok {
+		return breaks
+	}
+
+	// Patterns are matched against the word with a "." at each end, so that
+	// they can match the start or end of words
+	padded := make([]rune, 0, len(lower)+2)
+	padded = append(padded, '.')
+	padded = append(padded, lower...)
+	padded = append(padded, '.')
+
+	values := make([]byte, len(padded)+1)
+	for i := range padded {
+		for j := i + 1; j <= len(padded) && j-i <= h.longest; j++ {
+			pattern, ok := h.patterns[string(padded[i:j])]
+			if !ok {
+				continue
+			}
+			for k, v := range pattern {
+				if v > values[i+k] {
+					values[i+k] = v
+				}
+			}
+		}
+	}
+
+	left, right := h.LeftMin, h.RightMin
+	if left <= 0 {
+		left = defaultHyphenMin
+	}
+	if right <= 0 {
+		right = defaultHyphenMin
+	}
+
+	// values[i+1] lies before word[i], and odd values are breaks
+	var breaks []int
+	for i := left; i <= len(word)-right; i++ {
+		if values[i+1]%2 == 1 {
+			breaks = append(breaks, i)
+		}
+	}
+	return breaks
+}
+
+// Syllabify splits a word into its syllables, such as "hy", "phen", "a" and
+// "tion" for "hyphenation"
+func (h *Hyphenator) Syllabify(word string) []string {
+	runes := []rune(word)
+	if len(runes) == 0 {
+		return nil
+	}
+
+	var (
+		syllables []string
+		last      int
+	)
+	for _, i := range h.breaks(runes) {
+		if i <= last || i >= len(runes) {
+			continue
+		}
+		syllables = append(syllables, string(runes[last:i]))
+		last = i
+	}
+	return append(syllables, string(runes[last:]))
+}
+
+// Syllables implements SyllableCounter
+func (h *Hyphenator) Syllables(word string) int {
+	return len(h.Syllabify(word))
+}
+
+// Syllabify splits a word into its syllables using the embedded US English
+// hyphenation patterns. Use LoadHyphenator for other languages.
+func Syllabify(word string) []string {
+	return NewHyphenator().Syllabify(word)
+}
@@ -0,0 +1,81 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HyphenatorSuite struct {
+	suite.Suite
+}
+
+func (s *HyphenatorSuite) TestSyllabify() {
+	words := map[string][]string{
+		"hyphenation": {"hy", "phen", "a", "tion"},
+		"Computer":    {"Com", "put", "er"},
+		"syllable":    {"syl", "la", "ble"},
+		"academy":     {"acad", "e", "my"},
+		"nice":        {"nice"},
+		"a":           {"a"},
+	}
+	for word, syllables := range words {
+		s.Equal(syllables, Syllabify(word), word)
+		s.Equal(len(syllables), NewHyphenator().Syllables(word), word)
+	}
+
+	s.Equal([]string{"splits"}, Syllabify("splits"))
+	s.Nil(Syllabify(""))
+	s.Equal(0, NewHyphenator().Syllables(""))
+}
+
+func (s *HyphenatorSuite) TestMinimums() {
+	h := NewHyphenator()
+	s.Equal([]string{"Mu", "se", "um"}, h.Syllabify("Museum"))
+
+	h.RightMin = 3
+	s.Equal([]string{"Mu", "seum"}, h.Syllabify("Museum"))
+	s.Equal([]string{"Mu", "se", "um"}, Syllabify("Museum"))
+}
+
+func (s *HyphenatorSuite) TestLoadTeX() {
+	h, err := LoadHyphenator(strings.NewReader(`% German-ish patterns
+\message{Test patterns}
+\patterns{ % comment
+1ba 1be 1bi 1bo
+1ga 1ge
+}
+\hyphenation{
+Gab-el
+}`))
+	s.NoError(err)
+	s.Equal([]string{"le", "ben"}, h.Syllabify("leben"))
+	s.Equal([]string{"ga", "be"}, h.Syllabify("gabe"))
+	s.Equal([]string{"Gab", "el"}, h.Syllabify("Gabel"))
+
+	_, err = LoadHyphenator(strings.NewReader(`\patterns{1ba`))
+	s.Error(err)
+
+	_, err = LoadHyphenator(strings.NewReader(`\patterns{1ba 12}`))
+	s.Error(err)
+}
+
+func (s *HyphenatorSuite) TestLoadPlain() {
+	h, err := LoadHyphenator(strings.NewReader("1ba\n1be\nta-ble\n"))
+	s.NoError(err)
+	s.Equal([]string{"le", "ben"}, h.Syllabify("leben"))
+	s.Equal([]string{"ta", "ble"}, h.Syllabify("table"))
+	s.Equal([]string{"tab"}, h.Syllabify("tab"))
+}
+
+func (s *HyphenatorSuite) TestAnalyse() {
+	text := "Hyphenation splits syllables."
+
+	res, _ := Analyse(strings.NewReader(text), WithSyllableCounter(NewHyphenator()))
+	s.Equal(8, res.Syllables)
+}
+
+func TestHyphenator(t *testing.T) {
+	suite.Run(t, new(HyphenatorSuite))
+}
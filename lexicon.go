@@ -0,0 +1,58 @@
+package textstats
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+)
+
+// Lexicon is a set of ordinary words in lower case, used to tell proper nouns
+// and acronyms from words that are only capitalised because they start a
+// sentence or are in a heading. See WithLexicon.
+type Lexicon map[string]struct{}
+
+// NewLexicon returns a Lexicon holding the given words
+func NewLexicon(words ...string) Lexicon {
+	l := make(Lexicon, len(words))
+	for _, word := range words {
+		l[strings.ToLower(word)] = struct{}{}
+	}
+	return l
+}
+
+// LoadLexicon reads a Lexicon from r, which holds words separated by
+// whitespace, with anything following a # on a line ignored. A word list such
+// as /usr/share/dict/words can be used, as capitalised entries are proper
+// nouns and are skipped.
+func LoadLexicon(r io.Reader) (Lexicon, error) {
+	l := make(Lexicon)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		for _, word := range strings.Fields(text) {
+			if word == strings.ToLower(word) {
+				l[word] = struct{}{}
+			}
+		}
+	}
+
+	return l, scanner.Err()
+}
+
+// LoadLexiconFile reads a Lexicon from the named file. See LoadLexicon for the
+// format.
+func LoadLexiconFile(name string) (Lexicon, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadLexicon(f)
+}
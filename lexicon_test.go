@@ -0,0 +1,47 @@
+package textstats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LexiconSuite struct {
+	suite.Suite
+}
+
+const words = `
+# ordinary words
+apple banana   # fruit
+Paris London
+cherry
+`
+
+func (s *LexiconSuite) TestNew() {
+	s.Equal(Lexicon{"apple": {}, "banana": {}}, NewLexicon("Apple", "banana"))
+}
+
+func (s *LexiconSuite) TestLoad() {
+	l, err := LoadLexicon(strings.NewReader(words))
+	s.NoError(err)
+	s.Equal(NewLexicon("apple", "banana", "cherry"), l)
+}
+
+func (s *LexiconSuite) TestLoadFile() {
+	name := filepath.Join(s.T().TempDir(), "words.txt")
+	s.NoError(os.WriteFile(name, []byte(words), 0644))
+
+	l, err := LoadLexiconFile(name)
+	s.NoError(err)
+	s.Len(l, 3)
+
+	_, err = LoadLexiconFile(filepath.Join(s.T().TempDir(), "missing.txt"))
+	s.Error(err)
+}
+
+func TestLexicon(t *testing.T) {
+	suite.Run(t, new(LexiconSuite))
+}
@@ -0,0 +1,337 @@
+package textstats
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"unicode"
+)
+
+// maxAlternatives is the most sequences a pattern can expand to before it is
+// left to the regexp
+const maxAlternatives = 16
+
+// maxMatchLength is the longest word, in runes, that a matcher matches by
+// backtracking. Repeats can make backtracking take quadratic time, so longer
+// words are left to the regexp, which takes linear time.
+const maxMatchLength = 64
+
+// matcher matches a regexp from the syllable rules against a word held as
+// runes. The simple patterns the rules use, made of literals, character
+// classes, anchors, alternations and repeated characters, are matched by
+// backtracking without allocating. Anything else is left to the regexp.
+type matcher struct {
+	regex *regexp.Regexp
+	// alts are the sequences the pattern expands to, tried in order, or nil
+	// if the regexp is used instead
+	alts []sequence
+}
+
+// sequence is a pattern without alternations, with what is known about where
+// it can match
+type sequence struct {
+	items []matchItem
+	// begin and end are true if the sequence is anchored at the start or end
+	// of the word, min is the fewest runes it matches and fixed is true if it
+	// always matches min runes. first is the first item if it must match.
+	begin, end bool
+	min        int
+	fixed      bool
+	first      *matchItem
+}
+
+// starts returns the first and last positions the sequence could match from
+func (seq *sequence) starts(word []rune) (first, last int) {
+	last = len(word) - seq.min
+	switch {
+	case seq.begin:
+		return 0, 0
+	case seq.end && seq.fixed:
+		return last, last
+	}
+	return 0, last
+}
+
+// matchItem is a single step of a sequence: an anchor, or a set of runes
+// repeated between min and max times, with max < 0 for no limit
+type matchItem struct {
+	begin, end bool
+	ascii      [2]uint64
+	ranges     []rune
+	min, max   int
+}
+
+// has reports whether r is in the item's set
+func (it *matchItem) has(r rune) bool {
+	if r < 128 {
+		return it.ascii[r>>6]&(1<<(uint(r)&63)) != 0
+	}
+	for i := 0; i+1 < len(it.ranges); i += 2 {
+		if r < it.ranges[i] {
+			return false
+		}
+		if r <= it.ranges[i+1] {
+			return true
+		}
+	}
+	return false
+}
+
+// newMatcher compiles a regexp to a matcher, assuming it uses the default
+// Perl syntax
+func newMatcher(re *regexp.Regexp) *matcher {
+	m := &matcher{regex: re}
+
+	tree, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return m
+	}
+
+	alts, ok := sequences(tree.Simplify())
+	if !ok {
+		return m
+	}
+
+	for _, items := range alts {
+		seq := sequence{items: items, fixed: true}
+		if len(items) > 0 && !items[0].begin && !items[0].end && items[0].min > 0 {
+			seq.first = &items[0]
+		}
+		for i, it := range items {
+			seq.begin = seq.begin || (it.begin && i == 0)
+			seq.end = seq.end || (it.end && i == len(items)-1)
+			seq.min += it.min
+			seq.fixed = seq.fixed && it.min == it.max
+		}
+
+		// Patterns that can match nothing are rare, and replacing empty
+		// matches is subtle, so they are left to the regexp
+		if seq.min == 0 {
+			m.alts = nil
+			return m
+		}
+		m.alts = append(m.alts, seq)
+	}
+
+	return m
+}
+
+// sequences expands a parsed pattern into the sequences it matches, in the
+// order a backtracking match tries them
+func sequences(re *syntax.Regexp) ([][]matchItem, bool) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return [][]matchItem{nil}, true
+	case syntax.OpBeginText:
+		return [][]matchItem{{{begin: true}}}, true
+	case syntax.OpEndText:
+		return [][]matchItem{{{end: true}}}, true
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil, false
+		}
+		seq := make([]matchItem, len(re.Rune))
+		for i, r := range re.Rune {
+			seq[i] = runeSet([]rune{r, r})
+		}
+		return [][]matchItem{seq}, true
+	case syntax.OpCharClass, syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		it, ok := charItem(re)
+		return [][]matchItem{{it}}, ok
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest:
+		it, ok := charItem(re.Sub[0])
+		if !ok || re.Flags&syntax.NonGreedy != 0 {
+			return nil, false
+		}
+		switch re.Op {
+		case syntax.OpStar:
+			it.min, it.max = 0, -1
+		case syntax.OpPlus:
+			it.min, it.max = 1, -1
+		default:
+			it.min, it.max = 0, 1
+		}
+		return [][]matchItem{{it}}, true
+	case syntax.OpCapture:
+		return sequences(re.Sub[0])
+	case syntax.OpAlternate:
+		var alts [][]matchItem
+		for _, sub := range re.Sub {
+			subAlts, ok := sequences(sub)
+			if !ok {
+				return nil, false
+			}
+			alts = append(alts, subAlts...)
+		}
+		return alts, len(alts) <= maxAlternatives
+	case syntax.OpConcat:
+		alts := [][]matchItem{nil}
+		var repeats bool
+		for _, sub := range re.Sub {
+			subAlts, ok := sequences(sub)
+			if !ok {
+				return nil, false
+			}
+
+			// Backtracking would try every length of an earlier repeat
+			// before the next alternative, which the expanded sequences
+			// can't do
+			if repeats && len(subAlts) > 1 {
+				return nil, false
+			}
+			if len(alts)*len(subAlts) > maxAlternatives {
+				return nil, false
+			}
+
+			var next [][]matchItem
+			for _, alt := range alts {
+				for _, subAlt := range subAlts {
+					seq := append(append([]matchItem(nil), alt...), subAlt...)
+					next = append(next, seq)
+				}
+			}
+			alts = next
+
+			for _, subAlt := range subAlts {
+				for _, it := range subAlt {
+					repeats = repeats || it.min != it.max
+				}
+			}
+		}
+		return alts, true
+	}
+
+	return nil, false
+}
+
+// charItem returns the item matching a single character once
+func charItem(re *syntax.Regexp) (matchItem, bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if len(re.Rune) != 1 || re.Flags&syntax.FoldCase != 0 {
+			return matchItem{}, false
+		}
+		return runeSet([]rune{re.Rune[0], re.Rune[0]}), true
+	case syntax.OpCharClass:
+		return runeSet(re.Rune), true
+	case syntax.OpAnyCharNotNL:
+		return runeSet([]rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}), true
+	case syntax.OpAnyChar:
+		return runeSet([]rune{0, unicode.MaxRune}), true
+	}
+	return matchItem{}, false
+}
+
+// runeSet returns an item matching any rune in the sorted lo, hi pairs of
+// ranges once
+func runeSet(ranges []rune) matchItem {
+	it := matchItem{ranges: ranges, min: 1, max: 1}
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for r := ranges[i]; r <= ranges[i+1] && r < 128; r++ {
+			it.ascii[r>>6] |= 1 << (uint(r) & 63)
+		}
+	}
+	return it
+}
+
+// match reports whether the pattern matches anywhere in word
+func (m *matcher) match(word []rune) bool {
+	if m.alts == nil || len(word) > maxMatchLength {
+		return m.regex.MatchString(string(word))
+	}
+	_, _, ok := m.find(word, 0)
+	return ok
+}
+
+// remove removes every match of the pattern from word, reusing its storage
+func (m *matcher) remove(word []rune) []rune {
+	if m.alts == nil || len(word) > maxMatchLength {
+		replaced := m.regex.ReplaceAllString(string(word), "")
+		word = word[:0]
+		for _, r := range replaced {
+			word = append(word, r)
+		}
+		return word
+	}
+
+	// Matching only reads from pos onwards, so the kept runes can be moved
+	// down as the matches are found
+	var w, pos int
+	for pos < len(word) {
+		start, end, ok := m.find(word, pos)
+		if !ok {
+			break
+		}
+		w += copy(word[w:], word[pos:start])
+		pos = end
+	}
+	w += copy(word[w:], word[pos:])
+	return word[:w]
+}
+
+// find returns the span of the leftmost match starting at or after from
+func (m *matcher) find(word []rune, from int) (start, end int, ok bool) {
+	// Most rules are a single sequence, which is only tried where it could
+	// match
+	if len(m.alts) == 1 {
+		seq := &m.alts[0]
+		first, last := seq.starts(word)
+		if first < from {
+			first = from
+		}
+		for start = first; start <= last; start++ {
+			if seq.first != nil && !seq.first.has(word[start]) {
+				continue
+			}
+			if end, ok = matchAt(seq.items, word, start); ok {
+				return start, end, true
+			}
+		}
+		return 0, 0, false
+	}
+
+	for start = from; start < len(word); start++ {
+		for i := range m.alts {
+			seq := &m.alts[i]
+			if first, last := seq.starts(word); start < first || start > last {
+				continue
+			}
+			if end, ok = matchAt(seq.items, word, start); ok {
+				return start, end, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// matchAt returns the end of a match of seq starting at pos, preferring the
+// longest repeats first as a backtracking regexp would
+func matchAt(seq []matchItem, word []rune, pos int) (int, bool) {
+	if len(seq) == 0 {
+		return pos, true
+	}
+
+	it := &seq[0]
+	switch {
+	case it.begin:
+		if pos != 0 {
+			return 0, false
+		}
+		return matchAt(seq[1:], word, pos)
+	case it.end:
+		if pos != len(word) {
+			return 0, false
+		}
+		return matchAt(seq[1:], word, pos)
+	}
+
+	var n int
+	for pos+n < len(word) && (it.max < 0 || n < it.max) && it.has(word[pos+n]) {
+		n++
+	}
+	for ; n >= it.min; n-- {
+		if end, ok := matchAt(seq[1:], word, pos+n); ok {
+			return end, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,69 @@
+package textstats
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MatcherSuite struct {
+	suite.Suite
+}
+
+// matcherWords are words to check the matchers against their regexps with
+func (s *MatcherSuite) matcherWords() []string {
+	samples, err := LoadSyllableSamplesFile("testdata/syllables.txt")
+	s.Require().NoError(err)
+
+	words := []string{"", "a", "unless", "forefather", "coax", "mcdonald", "café", "naïve", "rosé", "crème", "über", "ériers",
+		strings.Repeat("supercalifragilistic", 4), strings.Repeat("é", 100)}
+	for _, sample := range samples {
+		words = append(words, sample.Word)
+	}
+	for word := range daleChallWordList {
+		words = append(words, word)
+	}
+	return words
+}
+
+func (s *MatcherSuite) TestBuiltinRules() {
+	words := s.matcherWords()
+	for re, m := range builtinMatchers {
+		s.NotNil(m.alts, "%s is left to the regexp", re)
+		for _, word := range words {
+			s.Equal(re.MatchString(word), m.match([]rune(word)), "%s %q", re, word)
+			s.Equal(re.ReplaceAllString(word, ""), string(m.remove([]rune(word))), "%s %q", re, word)
+		}
+	}
+}
+
+func (s *MatcherSuite) TestPatterns() {
+	patterns := []string{"a|b|cd", "^(x|ab)c", "b+c?$", "[^a-c]{2}e", "e(r|st)?s", "s$", "(?i)ly", `\bun`, "(ab)+", "a*?b", "x?", "ab|", "(a*)(b|c)"}
+	words := append(s.matcherWords(), "abcabc", "xcab", "bbbc", "ABLY", "sun un", "aaab", "abababc")
+
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		m := newMatcher(re)
+		for _, word := range words {
+			s.Equal(re.MatchString(word), m.match([]rune(word)), "%s %q", re, word)
+			s.Equal(re.ReplaceAllString(word, ""), string(m.remove([]rune(word))), "%s %q", re, word)
+		}
+	}
+}
+
+func (s *MatcherSuite) TestChangedRules() {
+	rules := DefaultSyllableRules()
+	s.Equal(4, rules.Syllables("advertisement"))
+
+	rules.PrefixSuffixes = append(rules.PrefixSuffixes, regexp.MustCompile("ment$"))
+	s.Equal(3, rules.Syllables("advertisement"))
+
+	rules.PrefixSuffixes = rules.PrefixSuffixes[:len(rules.PrefixSuffixes)-1]
+	s.Equal(4, rules.Syllables("advertisement"))
+}
+
+func TestMatchers(t *testing.T) {
+	suite.Run(t, new(MatcherSuite))
+}
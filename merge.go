@@ -0,0 +1,139 @@
+package textstats
+
+// Merge adds the results for the text that follows r, such as the next
+// chapter or chunk of a document, so that r holds the results for both. The
+// offsets in other's details are moved to follow r's text. Results combine
+// exactly when the text is split between paragraphs, as a split anywhere else
+// can end a sentence or a word early.
+func (r *Results) Merge(other *Results) {
+	if other == nil {
+		return
+	}
+
+	shifted := *other
+	shifted.WordDetails = shiftWords(other.WordDetails, r.Bytes)
+	shifted.SentenceDetails = shiftSentences(other.SentenceDetails, r.Bytes)
+	shifted.ParagraphDetails = shiftParagraphs(other.ParagraphDetails, r.Bytes)
+
+	r.add(&shifted)
+}
+
+// Add returns the results for r followed by other, as with Merge, without
+// changing either of them
+func (r *Results) Add(other *Results) *Results {
+	sum := newResults()
+	sum.Merge(r)
+	sum.Merge(other)
+	return sum
+}
+
+// clone returns a copy of r that can be changed without changing r
+func (r *Results) clone() *Results {
+	c := newResults()
+	c.add(r)
+	return c
+}
+
+// init makes the histograms of a zero Results
+func (r *Results) init() {
+	if r.syllableWords == nil {
+		r.syllableWords = make(map[int]int)
+	}
+	if r.syllableProperNouns == nil {
+		r.syllableProperNouns = make(map[int]int)
+	}
+	if r.letterWords == nil {
+		r.letterWords = make(map[int]int)
+	}
+	if r.sentenceWords == nil {
+		r.sentenceWords = make(map[int]int)
+	}
+}
+
+// add adds the counts and details in o to r, without changing any offsets
+func (r *Results) add(o *Results) {
+	r.init()
+
+	r.Words += o.Words
+	r.Sentences += o.Sentences
+	r.Letters += o.Letters
+	r.Punctuation += o.Punctuation
+	r.Spaces += o.Spaces
+	r.Syllables += o.Syllables
+	r.DifficultWords += o.DifficultWords
+	r.ComplexWords += o.ComplexWords
+	r.Numbers += o.Numbers
+	r.URLs += o.URLs
+	r.Emails += o.Emails
+	r.Mentions += o.Mentions
+	r.Hashtags += o.Hashtags
+	r.Paragraphs += o.Paragraphs
+	r.Acronyms += o.Acronyms
+	r.Bytes += o.Bytes
+
+	for sCount, wCount := range o.syllableWords {
+		r.syllableWords[sCount] += wCount
+	}
+	for sCount, wCount := range o.syllableProperNouns {
+		r.syllableProperNouns[sCount] += wCount
+	}
+	for lCount, wCount := range o.letterWords {
+		r.letterWords[lCount] += wCount
+	}
+	for wCount, sCount := range o.sentenceWords {
+		r.sentenceWords[wCount] += sCount
+	}
+
+	r.WordDetails = append(r.WordDetails, o.WordDetails...)
+	r.SentenceDetails = append(r.SentenceDetails, o.SentenceDetails...)
+	r.ParagraphDetails = append(r.ParagraphDetails, o.ParagraphDetails...)
+}
+
+// shiftWords returns a copy of words with their offsets moved by n bytes
+func shiftWords(words []WordResult, n int) []WordResult {
+	if n == 0 || words == nil {
+		return words
+	}
+
+	shifted := make([]WordResult, len(words))
+	for i, word := range words {
+		word.Offset += n
+		shifted[i] = word
+	}
+	return shifted
+}
+
+// shiftSentences returns a copy of sentences with their offsets moved by n
+// bytes
+func shiftSentences(sentences []SentenceResult, n int) []SentenceResult {
+	if n == 0 || sentences == nil {
+		return sentences
+	}
+
+	shifted := make([]SentenceResult, len(sentences))
+	for i, sentence := range sentences {
+		sentence.Start += n
+		sentence.End += n
+		sentence.WordDetails = shiftWords(sentence.WordDetails, n)
+		shifted[i] = sentence
+	}
+	return shifted
+}
+
+// shiftParagraphs returns a copy of paragraphs with their offsets moved by n
+// bytes
+func shiftParagraphs(paragraphs []ParagraphResult, n int) []ParagraphResult {
+	if n == 0 || paragraphs == nil {
+		return paragraphs
+	}
+
+	shifted := make([]ParagraphResult, len(paragraphs))
+	for i, paragraph := range paragraphs {
+		paragraph.Start += n
+		paragraph.End += n
+		paragraph.WordDetails = shiftWords(paragraph.WordDetails, n)
+		paragraph.SentenceDetails = shiftSentences(paragraph.SentenceDetails, n)
+		shifted[i] = paragraph
+	}
+	return shifted
+}
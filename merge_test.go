@@ -0,0 +1,62 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MergeSuite struct {
+	suite.Suite
+}
+
+var chapters = []string{
+	"Chapter one\n\nThe quick brown fox jumped. Nobody saw it!\n\n",
+	"Chapter two\n\nDr. Smith’s well-known dog barks about 2 times. #dogs\n\n",
+	"The end.",
+}
+
+func (s *MergeSuite) TestMerge() {
+	opts := []Option{WithWordDetails(), WithSentenceDetails(), WithParagraphDetails()}
+	whole, _ := Analyse(strings.NewReader(strings.Join(chapters, "")), opts...)
+
+	merged := &Results{}
+	for _, chapter := range chapters {
+		res, _ := Analyse(strings.NewReader(chapter), opts...)
+		merged.Merge(res)
+	}
+
+	s.Equal(whole, merged)
+	s.Equal(whole.FleschKincaidGradeLevel(), merged.FleschKincaidGradeLevel())
+	s.Equal(whole.WordsWithAtLeastNSyllables(2, false), merged.WordsWithAtLeastNSyllables(2, false))
+	s.Equal(whole.WordsPerSentence().Median(), merged.WordsPerSentence().Median())
+
+	for _, sentence := range merged.SentenceDetails {
+		s.Equal(sentence.Text, strings.Join(chapters, "")[sentence.Start:sentence.End])
+	}
+
+	merged.Merge(nil)
+	s.Equal(whole, merged)
+}
+
+func (s *MergeSuite) TestAdd() {
+	first, _ := Analyse(strings.NewReader(chapters[0]), WithWordDetails())
+	second, _ := Analyse(strings.NewReader(chapters[1]), WithWordDetails())
+	firstWords := first.Words
+	secondOffset := second.WordDetails[0].Offset
+
+	sum := first.Add(second)
+	s.Equal(first.Words+second.Words, sum.Words)
+	s.Equal(first.Bytes+second.Bytes, sum.Bytes)
+	s.Equal(len(chapters[0])+secondOffset, sum.WordDetails[firstWords].Offset)
+
+	// neither is changed
+	s.Equal(firstWords, first.Words)
+	s.Equal(secondOffset, second.WordDetails[0].Offset)
+	s.Len(first.WordDetails, firstWords)
+}
+
+func TestMerge(t *testing.T) {
+	suite.Run(t, new(MergeSuite))
+}
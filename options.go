@@ -0,0 +1,281 @@
+package textstats
+
+import (
+	"runtime"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Option configures an analysis
+type Option func(*config)
+
+// config holds the settings for a single analysis
+type config struct {
+	tokenizer        Tokenizer
+	language         string
+	abbreviations    map[string]struct{}
+	hyphens          HyphenMode
+	complexWords     ComplexWordMode
+	tokenRules       map[TokenKind]TokenRule
+	terminators      string
+	syllables        SyllableCounter
+	familiar         map[string]struct{}
+	dictionary       *Dictionary
+	properNoun       func(word string) bool
+	lexicon          Lexicon
+	wordDetails      bool
+	sentenceDetails  bool
+	paragraphs       ParagraphRule
+	paragraphDetails bool
+	workers          int
+	chunkSize        int64
+	maxBytes         int64
+	maxWords         int
+	timeout          time.Duration
+
+	// customProperNouns is true once WithProperNouns has replaced the built
+	// in proper noun rule with properNoun, and capitalised is true if
+	// WithCapitalisedProperNouns set it to IsCapitalised, so that words can
+	// be checked without making strings of them
+	customProperNouns bool
+	capitalised       bool
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		tokenizer:   DefaultTokenizer,
+		language:    "en",
+		tokenRules:  defaultTokenRules,
+		terminators: SentenceTerminators,
+		syllables:   defaultSyllableRules,
+		familiar:    daleChallWordList,
+		paragraphs:  BlankLines,
+		workers:     runtime.GOMAXPROCS(0),
+		chunkSize:   defaultChunkSize,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.abbreviations == nil {
+		cfg.abbreviations = abbreviationSets[cfg.language]
+	}
+
+	return cfg
+}
+
+// WithTokenizer sets the Tokenizer used to split text into words
+func WithTokenizer(t Tokenizer) Option {
+	return func(c *config) {
+		c.tokenizer = t
+	}
+}
+
+// WithLanguage sets the language of the text, such as "en" or "de", which
+// selects the abbreviations used to find the ends of sentences
+func WithLanguage(lang string) Option {
+	return func(c *config) {
+		c.language = lang
+	}
+}
+
+// WithAbbreviations replaces the abbreviations that can be followed by a full
+// stop without ending a sentence, such as "Dr" or "e.g.". Use
+// DefaultAbbreviations to extend the built in list instead.
+func WithAbbreviations(abbrs ...string) Option {
+	return func(c *config) {
+		c.abbreviations = abbreviationSet(abbrs)
+	}
+}
+
+// WithHyphenMode sets how hyphenated compounds such as "well-known" are
+// counted. The default is HyphenSplit.
+func WithHyphenMode(mode HyphenMode) Option {
+	return func(c *config) {
+		c.hyphens = mode
+	}
+}
+
+// WithComplexWordMode sets which words count as complex for the Gunning-Fog
+// score. The default is ComplexWordsGunning. ComplexWordsLegacy also restores
+// the earlier proper noun rule, unless WithProperNouns or
+// WithCapitalisedProperNouns is used.
+func WithComplexWordMode(mode ComplexWordMode) Option {
+	return func(c *config) {
+		c.complexWords = mode
+	}
+}
+
+// WithTokenRule sets how tokens of the given kind, such as NumberToken, are
+// counted towards words and syllables. For example, WithTokenRule(NumberToken,
+// SpellNumber) counts "1999" as the words "nineteen ninety-nine".
+func WithTokenRule(kind TokenKind, rule TokenRule) Option {
+	return func(c *config) {
+		rules := make(map[TokenKind]TokenRule, len(c.tokenRules)+1)
+		for k, r := range c.tokenRules {
+			rules[k] = r
+		}
+		rules[kind] = rule
+		c.tokenRules = rules
+	}
+}
+
+// WithSentenceTerminators sets the runes that can end a sentence. The default
+// is SentenceTerminators.
+func WithSentenceTerminators(terminators string) Option {
+	return func(c *config) {
+		c.terminators = terminators
+	}
+}
+
+// WithSyllableCounter sets the SyllableCounter used to count the syllables
+// in each word. The default counts them with DefaultSyllableRules.
+func WithSyllableCounter(counter SyllableCounter) Option {
+	return func(c *config) {
+		c.syllables = counter
+	}
+}
+
+// WithSyllableRules sets the rules used to count syllables. Use
+// DefaultSyllableRules to adjust the built in rules.
+func WithSyllableRules(rules *SyllableRules) Option {
+	return WithSyllableCounter(rules)
+}
+
+// WithFamiliarWords sets the familiar words used to find difficult words for
+// the Dale-Chall readability score. The default is the Dale-Chall list. Use
+// WithDictionary to add to or remove from the default list instead.
+func WithFamiliarWords(words map[string]struct{}) Option {
+	return func(c *config) {
+		c.familiar = words
+	}
+}
+
+// WithDictionary adds to and removes from the problem and familiar word lists
+// for the analysis
+func WithDictionary(d *Dictionary) Option {
+	return func(c *config) {
+		c.dictionary = d
+	}
+}
+
+// WithProperNouns sets the function used to decide if a word is a proper
+// noun, which excludes it from the Gunning-Fog complex word count. If nil, no
+// words are treated as proper nouns.
+//
+// By default capitalised words are proper nouns, apart from single letters,
+// words in capitals and the first word of a sentence. See WithLexicon.
+func WithProperNouns(fn func(word string) bool) Option {
+	return func(c *config) {
+		c.properNoun = fn
+		c.customProperNouns = true
+		c.capitalised = false
+	}
+}
+
+// WithCapitalisedProperNouns treats every capitalised word as a proper noun,
+// including the first word of each sentence, as earlier versions did. It is
+// the same as WithProperNouns(IsCapitalised), but faster.
+func WithCapitalisedProperNouns() Option {
+	return func(c *config) {
+		c.properNoun = IsCapitalised
+		c.customProperNouns = true
+		c.capitalised = true
+	}
+}
+
+// WithLexicon sets the ordinary words used to tell proper nouns and acronyms
+// from words that are only capitalised. The first word of a sentence is a
+// proper noun if it is capitalised and not in the Lexicon, and a word in
+// capitals is only an acronym if it isn't in the Lexicon.
+func WithLexicon(l Lexicon) Option {
+	return func(c *config) {
+		c.lexicon = l
+	}
+}
+
+// WithWordDetails records every word in Results.WordDetails, with its offset,
+// syllable count and whether it was treated as a proper noun or difficult
+// word
+func WithWordDetails() Option {
+	return func(c *config) {
+		c.wordDetails = true
+	}
+}
+
+// WithSentenceDetails records every sentence in Results.SentenceDetails, with
+// its text and its own results
+func WithSentenceDetails() Option {
+	return func(c *config) {
+		c.sentenceDetails = true
+	}
+}
+
+// WithParagraphRule sets the rule that decides which runs of whitespace
+// separate paragraphs. The default is BlankLines. If nil, the whole text is a
+// single paragraph.
+func WithParagraphRule(rule ParagraphRule) Option {
+	return func(c *config) {
+		c.paragraphs = rule
+	}
+}
+
+// WithParagraphDetails records every paragraph in Results.ParagraphDetails,
+// with its text and its own results
+func WithParagraphDetails() Option {
+	return func(c *config) {
+		c.paragraphDetails = true
+	}
+}
+
+// WithWorkers sets the number of chunks AnalyseParallel analyses at the same
+// time. The default is runtime.GOMAXPROCS(0).
+func WithWorkers(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// WithChunkSize sets the size in bytes that AnalyseParallel aims for when it
+// splits text into chunks. Chunks end at the first paragraph break after this
+// size, so they are usually a little larger.
+func WithChunkSize(size int64) Option {
+	return func(c *config) {
+		if size > 0 {
+			c.chunkSize = size
+		}
+	}
+}
+
+// WithMaxBytes stops the analysis with a LimitError if the text is longer than
+// n bytes
+func WithMaxBytes(n int64) Option {
+	return func(c *config) {
+		c.maxBytes = n
+	}
+}
+
+// WithMaxWords stops the analysis with a LimitError if the text has more than
+// n words
+func WithMaxWords(n int) Option {
+	return func(c *config) {
+		c.maxWords = n
+	}
+}
+
+// WithTimeout stops the analysis with a LimitError if it takes longer than d
+func WithTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// IsCapitalised reports whether a word starts with an upper case letter
+func IsCapitalised(word string) bool {
+	r, _ := utf8.DecodeRuneInString(word)
+	return unicode.IsUpper(r)
+}
@@ -0,0 +1,99 @@
+package textstats
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OptionsSuite struct {
+	suite.Suite
+}
+
+func (s *OptionsSuite) TestSyllableRules() {
+	rules := DefaultSyllableRules()
+	rules.ProblemWords["hello"] = 5
+	rules.AddSyllables = append(rules.AddSyllables, regexp.MustCompile("^world$"))
+
+	res, _ := Analyse(strings.NewReader("hello world"), WithSyllableRules(rules))
+	s.Equal(7, res.Syllables)
+
+	res, _ = Analyse(strings.NewReader("hello world"))
+	s.Equal(3, res.Syllables)
+	s.NotContains(ProblemWords, "hello")
+}
+
+func (s *OptionsSuite) TestFamiliarWords() {
+	res, _ := Analyse(strings.NewReader("quick brown dogs"), WithFamiliarWords(map[string]struct{}{
+		"quick": {},
+		"dog":   {},
+	}))
+	s.Equal(1, res.DifficultWords)
+}
+
+func (s *OptionsSuite) TestSentenceTerminators() {
+	res, _ := Analyse(strings.NewReader("One; two; three"), WithSentenceTerminators(";"))
+	s.Equal(3, res.Sentences)
+
+	res, _ = Analyse(strings.NewReader("One. Two"), WithSentenceTerminators(";"))
+	s.Equal(1, res.Sentences)
+}
+
+func (s *OptionsSuite) TestProperNouns() {
+	res, _ := Analyse(strings.NewReader(hw))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(1, false))
+
+	res, _ = Analyse(strings.NewReader(hw), WithProperNouns(IsCapitalised))
+	s.Equal(4, res.WordsWithAtLeastNSyllables(1, false))
+
+	res, _ = Analyse(strings.NewReader(hw), WithCapitalisedProperNouns())
+	s.Equal(4, res.WordsWithAtLeastNSyllables(1, false))
+
+	// A function that behaves like IsCapitalised is called for each word
+	var calls int
+	res, _ = Analyse(strings.NewReader(hw), WithProperNouns(func(word string) bool {
+		calls++
+		return IsCapitalised(word)
+	}))
+	s.Equal(4, res.WordsWithAtLeastNSyllables(1, false))
+	s.Equal(6, calls)
+
+	res, _ = Analyse(strings.NewReader(hw), WithCapitalisedProperNouns(), WithProperNouns(nil))
+	s.Equal(6, res.WordsWithAtLeastNSyllables(1, false))
+
+	res, _ = Analyse(strings.NewReader(hw), WithProperNouns(nil))
+	s.Equal(6, res.WordsWithAtLeastNSyllables(1, false))
+
+	res, _ = Analyse(strings.NewReader(hw), WithProperNouns(func(word string) bool {
+		return word == "absolutely"
+	}))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(1, false))
+}
+
+func (s *OptionsSuite) TestConcurrentOptions() {
+	rules := DefaultSyllableRules()
+	rules.ProblemWords["fox"] = 3
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			res, _ := Analyse(strings.NewReader(qbf), WithSyllableRules(rules))
+			s.Equal(13, res.Syllables)
+		}()
+		go func() {
+			defer wg.Done()
+			res, _ := Analyse(strings.NewReader(qbf))
+			s.Equal(11, res.Syllables)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestOptions(t *testing.T) {
+	suite.Run(t, new(OptionsSuite))
+}
@@ -0,0 +1,75 @@
+package textstats
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// ParagraphResult is the analysis of a single paragraph. Its Results count
+// only the words and sentences in the paragraph.
+type ParagraphResult struct {
+	// Start and End are the byte offsets of the paragraph in the text
+	Start, End int
+
+	// Text is the text of the paragraph
+	Text string
+
+	Results
+}
+
+// ParagraphRule reports whether a run of whitespace separates two paragraphs.
+// A paragraph break also ends any sentence that is still open, so headings
+// and list items without a full stop are counted as sentences of their own.
+type ParagraphRule func(space []byte) bool
+
+// paragraphSeparator is the Unicode paragraph separator
+const paragraphSeparator = '\u2029'
+
+// BlankLines is a ParagraphRule that separates paragraphs with one or more
+// blank lines. It is the default.
+func BlankLines(space []byte) bool {
+	return lineBreaks(space) >= 2 || bytes.ContainsRune(space, paragraphSeparator)
+}
+
+// LineBreaks is a ParagraphRule that starts a new paragraph on every line
+func LineBreaks(space []byte) bool {
+	return lineBreaks(space) >= 1 || bytes.ContainsRune(space, paragraphSeparator)
+}
+
+// IndentedLines is a ParagraphRule that starts a new paragraph after a blank
+// line or on a line that starts with a tab or at least two spaces
+func IndentedLines(space []byte) bool {
+	if BlankLines(space) {
+		return true
+	}
+
+	i := bytes.LastIndexAny(space, "\r\n\u2028")
+	if i < 0 {
+		return false
+	}
+	_, size := utf8.DecodeRune(space[i:])
+	indent := space[i+size:]
+
+	return bytes.HasPrefix(indent, []byte("\t")) || bytes.HasPrefix(indent, []byte("  "))
+}
+
+// lineBreaks returns the number of line breaks in a run of whitespace,
+// counting "\r\n" as one and including the Unicode line separator
+func lineBreaks(space []byte) int {
+	var n int
+	for i := 0; i < len(space); {
+		r, size := utf8.DecodeRune(space[i:])
+		i += size
+
+		switch r {
+		case '\r':
+			if i < len(space) && space[i] == '\n' {
+				i++
+			}
+			n++
+		case '\n', '\u2028':
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,137 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ParagraphSuite struct {
+	suite.Suite
+}
+
+const paragraphs = `Introduction
+
+The quick brown fox jumped. It was very quick!
+  Nobody saw it.
+
+The end.
+`
+
+func (s *ParagraphSuite) TestParagraphs() {
+	texts := map[string]int{
+		"":                       0,
+		"  \n\n  ":               0,
+		"One paragraph.":         1,
+		"One.\nStill one.":       1,
+		"One.\n\nTwo.":           2,
+		"One.\r\n\r\nTwo.":       2,
+		"One.\u2029Two.":         2,
+		"One.\n\n...\n\nTwo.":    2,
+		"\n\nOne.\n\n\n\nTwo.\n": 2,
+		paragraphs:               3,
+	}
+
+	for text, count := range texts {
+		res, _ := Analyse(strings.NewReader(text))
+		s.Equal(count, res.Paragraphs, "%q", text)
+	}
+}
+
+func (s *ParagraphSuite) TestBreakEndsSentence() {
+	res, _ := Analyse(strings.NewReader(paragraphs))
+	s.Equal(5, res.Sentences)
+	s.Equal(5.0/3, res.AverageSentencesPerParagraph())
+	s.Equal(float64(res.Words)/3, res.AverageWordsPerParagraph())
+
+	res, _ = Analyse(strings.NewReader("Heading\n\nText"), WithParagraphRule(nil))
+	s.Equal(1, res.Paragraphs)
+	s.Equal(1, res.Sentences)
+}
+
+func (s *ParagraphSuite) TestRules() {
+	space := map[string][3]bool{
+		" ":            {false, false, false},
+		"\n":           {false, true, false},
+		"\n\n":         {true, true, true},
+		" \r\n \n ":    {true, true, true},
+		"\n\t":         {false, true, true},
+		"\n  ":         {false, true, true},
+		"\n ":          {false, true, false},
+		"  ":           {false, false, false},
+		"\u2028":       {false, true, false},
+		"\u2028\u2028": {true, true, true},
+	}
+
+	for text, want := range space {
+		s.Equal(want[0], BlankLines([]byte(text)), "%q", text)
+		s.Equal(want[1], LineBreaks([]byte(text)), "%q", text)
+		s.Equal(want[2], IndentedLines([]byte(text)), "%q", text)
+	}
+
+	res, _ := Analyse(strings.NewReader(paragraphs), WithParagraphRule(IndentedLines))
+	s.Equal(4, res.Paragraphs)
+
+	res, _ = Analyse(strings.NewReader(paragraphs), WithParagraphRule(LineBreaks))
+	s.Equal(4, res.Paragraphs)
+}
+
+func (s *ParagraphSuite) TestParagraphDetails() {
+	res, _ := Analyse(strings.NewReader(paragraphs))
+	s.Nil(res.ParagraphDetails)
+
+	res, _ = Analyse(strings.NewReader(paragraphs), WithParagraphDetails(), WithSentenceDetails())
+	s.Require().Len(res.ParagraphDetails, 3)
+
+	var words, sentences int
+	for _, paragraph := range res.ParagraphDetails {
+		s.Equal(paragraph.Text, paragraphs[paragraph.Start:paragraph.End])
+		s.Equal(1, paragraph.Paragraphs)
+		s.Len(paragraph.SentenceDetails, paragraph.Sentences)
+		words += paragraph.Words
+		sentences += paragraph.Sentences
+	}
+	s.Equal(res.Words, words)
+	s.Equal(res.Sentences, sentences)
+	s.Len(res.SentenceDetails, res.Sentences)
+
+	p := res.ParagraphDetails[1]
+	s.Equal("The quick brown fox jumped. It was very quick!\n  Nobody saw it.", p.Text)
+	s.Equal(3, p.Sentences)
+	s.Equal("Nobody saw it.", p.SentenceDetails[2].Text)
+	s.Equal("Introduction", res.SentenceDetails[0].Text)
+
+	plain, _ := Analyse(strings.NewReader(paragraphs))
+	s.Equal(plain.Paragraphs, res.Paragraphs)
+	s.Equal(plain.Spaces, res.Spaces)
+	s.Equal(plain.Punctuation, res.Punctuation)
+	s.Equal(plain.Syllables, res.Syllables)
+
+	res, _ = Analyse(strings.NewReader(paragraphs), WithParagraphDetails())
+	s.Len(res.ParagraphDetails, 3)
+	s.Nil(res.SentenceDetails)
+	s.Equal("The end.", res.ParagraphDetails[2].Text)
+}
+
+func (s *ParagraphSuite) TestWordlessParagraph() {
+	text := "***\n\nHello world. Bye now."
+	res, _ := Analyse(strings.NewReader(text), WithParagraphDetails(), WithSentenceDetails())
+	s.Equal(1, res.Paragraphs)
+	s.Equal(5, res.Punctuation)
+	s.Require().Len(res.ParagraphDetails, 1)
+
+	p := res.ParagraphDetails[0]
+	s.Equal(5, p.Start)
+	s.Equal("Hello world. Bye now.", p.Text)
+	s.Equal(2, p.Punctuation)
+	s.Require().Len(p.SentenceDetails, 2)
+	s.Equal("Hello world.", p.SentenceDetails[0].Text)
+
+	res, _ = Analyse(strings.NewReader(text), WithSentenceDetails())
+	s.Equal("Hello world.", res.SentenceDetails[0].Text)
+}
+
+func TestParagraphs(t *testing.T) {
+	suite.Run(t, new(ParagraphSuite))
+}
@@ -0,0 +1,209 @@
+package textstats
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+// defaultChunkSize is the size of the chunks AnalyseParallel splits text into
+// unless set with WithChunkSize
+const defaultChunkSize = 1 << 20
+
+// AnalyseParallel analyses the first size bytes of r, splitting the text into
+// chunks that are analysed at the same time and merged into a single Results.
+// The results are identical to those from Analyse, including the offsets of
+// sentences and paragraphs.
+//
+// Chunks are only split at paragraph breaks, which end any open sentence.
+// Text without paragraph breaks, or with none after the chunk size set with
+// WithChunkSize, is a single chunk and is analysed by one worker, as Analyse
+// would. Whitespace is found with unicode.IsSpace, so a custom Tokenizer must
+// agree with it.
+//
+// Text longer than WithMaxBytes allows isn't analysed at all. Once the chunks
+// analysed so far have more words than WithMaxWords allows, the others are
+// stopped and the Results for the chunks so far are returned with a
+// LimitError. As chunks finish in any order, those Results can hold more
+// words than Analyse would have counted before stopping.
+func AnalyseParallel(r io.ReaderAt, size int64, opts ...Option) (*Results, error) {
+	return AnalyseParallelContext(context.Background(), r, size, opts...)
+}
+
+// AnalyseParallelContext is like AnalyseParallel, but stops once ctx is done
+func AnalyseParallelContext(ctx context.Context, r io.ReaderAt, size int64, opts ...Option) (*Results, error) {
+	cfg := newConfig(opts)
+	if cfg.maxBytes > 0 && size > cfg.maxBytes {
+		return nil, &LimitError{Limit: BytesLimit, Max: cfg.maxBytes}
+	}
+
+	parent := ctx
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+
+	// stop cancels the chunks still to be analysed once the word limit is
+	// reached
+	ctx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	chunks, err := chunkBounds(r, size, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The byte limit and timeout apply to the whole text and are handled
+	// here, while no chunk can have more words than the whole text
+	chunkCfg := *cfg
+	chunkCfg.maxBytes, chunkCfg.timeout = 0, 0
+
+	results := make([]*Results, len(chunks)-1)
+	errs := make([]error, len(chunks)-1)
+
+	var words int64
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.workers && w < len(results); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				chunk := io.NewSectionReader(r, chunks[i], chunks[i+1]-chunks[i])
+				results[i], errs[i] = analyse(ctx, chunk, &chunkCfg)
+				if cfg.maxWords > 0 && atomic.AddInt64(&words, int64(results[i].Words)) > int64(cfg.maxWords) {
+					stop()
+				}
+			}
+		}()
+	}
+
+	var sent int
+send:
+	for sent < len(results) {
+		select {
+		case next <- sent:
+			sent++
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	res := newResults()
+	for i, chunk := range results {
+		if chunk == nil {
+			continue
+		}
+		res.Merge(chunk)
+
+		switch {
+		case errs[i] == nil, errs[i] == ErrNoWords:
+		case errs[i] == ctx.Err() && parent.Err() == nil:
+			err = &LimitError{Limit: DurationLimit, Max: int64(cfg.timeout)}
+		case err == nil:
+			err = errs[i]
+		}
+	}
+
+	switch {
+	case cfg.maxWords > 0 && res.Words > cfg.maxWords:
+		return res, &LimitError{Limit: WordsLimit, Max: int64(cfg.maxWords)}
+	case err == nil && sent < len(results):
+		// The context ended before the remaining chunks were started
+		err = ctx.Err()
+		if parent.Err() == nil {
+			err = &LimitError{Limit: DurationLimit, Max: int64(cfg.timeout)}
+		}
+		return res, err
+	case err != nil:
+		return res, err
+	case res.Words == 0:
+		return res, ErrNoWords
+	}
+
+	return res, nil
+}
+
+// AnalyseFile analyses the named file with AnalyseParallel
+func AnalyseFile(name string, opts ...Option) (*Results, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return AnalyseParallel(f, info.Size(), opts...)
+}
+
+// chunkBounds returns the offsets where the chunks of the text start,
+// followed by the size of the text
+func chunkBounds(r io.ReaderAt, size int64, cfg *config) ([]int64, error) {
+	bounds := []int64{0}
+	for start := int64(0); start < size; {
+		end := size
+		if target := start + cfg.chunkSize; target < size && cfg.paragraphs != nil {
+			var err error
+			if end, err = paragraphBreak(r, target, size, cfg.paragraphs); err != nil {
+				return nil, err
+			}
+		}
+
+		bounds = append(bounds, end)
+		start = end
+	}
+
+	return bounds, nil
+}
+
+// paragraphBreak returns the offset of the end of the first paragraph break
+// after offset, or size if there isn't one. A break that offset falls inside
+// is skipped, as its whitespace may have started before offset.
+func paragraphBreak(r io.ReaderAt, offset, size int64, rule ParagraphRule) (int64, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, offset, size-offset))
+
+	var (
+		space  []byte
+		prev   rune
+		skip   = true
+		cursor = offset
+	)
+	for {
+		c, n, err := br.ReadRune()
+		if err == io.EOF {
+			return size, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if unicode.IsSpace(c) {
+			space = append(space, string(c)...)
+			cursor += int64(n)
+			continue
+		}
+
+		// A hyphen at the end of a line can join the words either side of it
+		// into a single token, so it isn't split there
+		if len(space) > 0 && !skip && !strings.ContainsRune(Hyphens, prev) && rule(space) {
+			return cursor, nil
+		}
+
+		skip = false
+		space = space[:0]
+		prev = c
+		cursor += int64(n)
+	}
+}
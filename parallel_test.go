@@ -0,0 +1,132 @@
+package textstats
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ParallelSuite struct {
+	suite.Suite
+}
+
+// corpus returns a text with many paragraphs
+func corpus() string {
+	var b strings.Builder
+	for i := 0; i < 20; i++ {
+		b.WriteString(strings.Join(chapters, ""))
+		b.WriteString("\n\n  " + lorem + "\r\n\r\n")
+		b.WriteString("A line-end exam-\nple. Heading\n\n@someone #tag ")
+	}
+	return b.String()
+}
+
+func (s *ParallelSuite) TestIdentical() {
+	text := corpus()
+	opts := []Option{WithWordDetails(), WithSentenceDetails(), WithParagraphDetails()}
+	serial, err := Analyse(strings.NewReader(text), opts...)
+	s.Require().NoError(err)
+
+	for _, chunkSize := range []int64{1, 7, 64, 1000, 1 << 20} {
+		for _, workers := range []int{1, 3, 8} {
+			res, err := AnalyseParallel(strings.NewReader(text), int64(len(text)),
+				append(opts, WithChunkSize(chunkSize), WithWorkers(workers))...)
+			s.NoError(err)
+			s.Equal(serial, res, "chunk size %d, %d workers", chunkSize, workers)
+		}
+	}
+}
+
+func (s *ParallelSuite) TestDifferential() {
+	texts := []string{
+		"***\n\nHello world. Bye now.",
+		"http://x.comv1.2\xffİ\n\na@b\r\n...",
+		"Hi. 12\n\nBye.",
+		"\n\n...\n\nOne.\n\n***\n\n",
+	}
+
+	// Random mixes of words, entities, punctuation and breaks, including
+	// paragraphs without words
+	frags := []string{
+		"Hello", "world.", "Dr.", "e.g.,", "Bye!", "12", "v1.2", "http://x.com",
+		"a@b", "@me", "#tag", "x-\ny", "...", "***", "©", "“", "”", "-", "\xff",
+		"İ", "\u200b", " ", "\t", "\n", "\r\n", "\n\n",
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		var b strings.Builder
+		for j := rng.Intn(16); j >= 0; j-- {
+			b.WriteString(frags[rng.Intn(len(frags))])
+		}
+		texts = append(texts, b.String())
+	}
+
+	opts := []Option{WithWordDetails(), WithSentenceDetails(), WithParagraphDetails()}
+	for _, text := range texts {
+		serial, serialErr := Analyse(strings.NewReader(text), opts...)
+		for _, chunkSize := range []int64{1, 5} {
+			res, err := AnalyseParallel(strings.NewReader(text), int64(len(text)), append(opts, WithChunkSize(chunkSize))...)
+			s.Equal(serialErr, err, "%q", text)
+			s.Equal(serial, res, "%q, chunk size %d", text, chunkSize)
+		}
+	}
+}
+
+func (s *ParallelSuite) TestRules() {
+	text := corpus()
+	for _, rule := range []ParagraphRule{LineBreaks, IndentedLines, nil} {
+		serial, _ := Analyse(strings.NewReader(text), WithParagraphRule(rule))
+		res, err := AnalyseParallel(strings.NewReader(text), int64(len(text)), WithParagraphRule(rule), WithChunkSize(16))
+		s.NoError(err)
+		s.Equal(serial, res)
+	}
+}
+
+func (s *ParallelSuite) TestChunks() {
+	text := "One.\n\nTwo.\n\nThree."
+	cfg := newConfig([]Option{WithChunkSize(1)})
+	bounds, err := chunkBounds(strings.NewReader(text), int64(len(text)), cfg)
+	s.NoError(err)
+	s.Equal([]int64{0, 6, 12, int64(len(text))}, bounds)
+
+	// a break that the target falls inside is skipped
+	cfg = newConfig([]Option{WithChunkSize(5)})
+	bounds, _ = chunkBounds(strings.NewReader(text), int64(len(text)), cfg)
+	s.Equal([]int64{0, 12, int64(len(text))}, bounds)
+
+	bounds, _ = chunkBounds(strings.NewReader(qbf), int64(len(qbf)), cfg)
+	s.Equal([]int64{0, int64(len(qbf))}, bounds)
+
+	bounds, _ = chunkBounds(strings.NewReader(""), 0, cfg)
+	s.Equal([]int64{0}, bounds)
+}
+
+func (s *ParallelSuite) TestNoWords() {
+	res, err := AnalyseParallel(strings.NewReader("\n\n...\n\n"), 7, WithChunkSize(1))
+	s.Equal(ErrNoWords, err)
+	s.Equal(7, res.Bytes)
+}
+
+func (s *ParallelSuite) TestFile() {
+	dir := s.T().TempDir()
+
+	text := corpus()
+	name := filepath.Join(dir, "corpus.txt")
+	s.Require().NoError(os.WriteFile(name, []byte(text), 0600))
+
+	serial, _ := Analyse(strings.NewReader(text))
+	res, err := AnalyseFile(name, WithChunkSize(100))
+	s.NoError(err)
+	s.Equal(serial, res)
+
+	_, err = AnalyseFile(filepath.Join(dir, "missing.txt"))
+	s.Error(err)
+}
+
+func TestParallel(t *testing.T) {
+	suite.Run(t, new(ParallelSuite))
+}
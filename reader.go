@@ -2,14 +2,18 @@ package textstats
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"math"
-	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
 
-// Results is a struct containing the results of an analysis
+// Results is a struct containing the results of an analysis. The averages and
+// readability scores are 0 for text without any words.
 type Results struct {
 	Words          int
 	Sentences      int
@@ -18,20 +22,92 @@ type Results struct {
 	Spaces         int
 	Syllables      int
 	DifficultWords int
+	Numbers        int
+	URLs           int
+	Emails         int
+	Mentions       int
+	Hashtags       int
+	Paragraphs     int
+
+	// ComplexWords is the number of words counted as complex for the
+	// Gunning-Fog score. See ComplexWordMode.
+	ComplexWords int
+
+	// Acronyms is the number of words in capitals, such as "NASA", that
+	// aren't in the Lexicon set with WithLexicon
+	Acronyms int
+
+	// Bytes is the length of the text
+	Bytes int
+
+	// WordDetails holds every word in the order it was seen, if the analysis
+	// was run with WithWordDetails
+	WordDetails []WordResult
+
+	// SentenceDetails holds every sentence in the order it was seen, if the
+	// analysis was run with WithSentenceDetails
+	SentenceDetails []SentenceResult
+
+	// ParagraphDetails holds every paragraph in the order it was seen, if the
+	// analysis was run with WithParagraphDetails
+	ParagraphDetails []ParagraphResult
 
 	syllableProperNouns map[int]int
 	syllableWords       map[int]int
+	letterWords         map[int]int
+	sentenceWords       map[int]int
+}
+
+// WordResult is the analysis of a single word
+type WordResult struct {
+	// Word is the letters of the word
+	Word string `json:"word" yaml:"word"`
+
+	// Offset is the byte offset of the word in the text. Words that a
+	// TokenRule makes from a number or other token share its offset.
+	Offset int `json:"offset" yaml:"offset"`
+
+	// Syllables is the number of syllables in the word
+	Syllables int `json:"syllables" yaml:"syllables"`
+
+	// ProperNoun is true if the word was treated as a proper noun
+	ProperNoun bool `json:"proper_noun" yaml:"proper_noun"`
+
+	// Acronym is true if the word was counted as an acronym
+	Acronym bool `json:"acronym" yaml:"acronym"`
+
+	// Difficult is true if the word counted as difficult for the Dale-Chall
+	// readability score
+	Difficult bool `json:"difficult" yaml:"difficult"`
+
+	// Complex is true if the word counted as complex for the Gunning-Fog score
+	Complex bool `json:"complex" yaml:"complex"`
+}
+
+func newResults() *Results {
+	return &Results{
+		syllableWords:       make(map[int]int),
+		syllableProperNouns: make(map[int]int),
+		letterWords:         make(map[int]int),
+		sentenceWords:       make(map[int]int),
+	}
 }
 
 // AverageLettersPerWord returns the average number of letters per word in the
 // text
 func (r *Results) AverageLettersPerWord() float64 {
+	if r.Words == 0 {
+		return 0
+	}
 	return float64(r.Letters) / float64(r.Words)
 }
 
 // AverageSyllablesPerWord returns the average number of syllables per word in
 // the text
 func (r *Results) AverageSyllablesPerWord() float64 {
+	if r.Words == 0 {
+		return 0
+	}
 	return float64(r.Syllables) / float64(r.Words)
 }
 
@@ -44,6 +120,24 @@ func (r *Results) AverageWordsPerSentence() float64 {
 	return float64(r.Words) / float64(r.Sentences)
 }
 
+// AverageSentencesPerParagraph returns the average number of sentences per
+// paragraph in the text
+func (r *Results) AverageSentencesPerParagraph() float64 {
+	if r.Paragraphs == 0 {
+		return float64(r.Sentences)
+	}
+	return float64(r.Sentences) / float64(r.Paragraphs)
+}
+
+// AverageWordsPerParagraph returns the average number of words per paragraph
+// in the text
+func (r *Results) AverageWordsPerParagraph() float64 {
+	if r.Paragraphs == 0 {
+		return float64(r.Words)
+	}
+	return float64(r.Words) / float64(r.Paragraphs)
+}
+
 // WordsWithAtLeastNSyllables returns the number of words with at least N
 // syllables, including or excluding proper nouns, in the text
 func (r *Results) WordsWithAtLeastNSyllables(n int, incProperNouns bool) int {
@@ -72,27 +166,44 @@ func (r *Results) WordsWithAtLeastNSyllables(n int, incProperNouns bool) int {
 // PercentageWordsWithAtLeastNSyllables returns the percentage of words with at
 // least N syllables, including or excluding proper nouns, in the text
 func (r *Results) PercentageWordsWithAtLeastNSyllables(n int, incProperNouns bool) float64 {
+	if r.Words == 0 {
+		return 0
+	}
 	return (float64(r.WordsWithAtLeastNSyllables(n, incProperNouns)) / float64(r.Words)) * 100.0
 }
 
 // FleschKincaidReadingEase returns the Flesch-Kincaid reading ease score for
 // given text
 func (r *Results) FleschKincaidReadingEase() float64 {
+	if r.Words == 0 {
+		return 0
+	}
 	return 206.835 - (1.015 * r.AverageWordsPerSentence()) - (84.6 * r.AverageSyllablesPerWord())
 }
 
 // FleschKincaidGradeLevel returns the Flesch-Kincaid grade level for the given text
 func (r *Results) FleschKincaidGradeLevel() float64 {
+	if r.Words == 0 {
+		return 0
+	}
 	return (0.39 * r.AverageWordsPerSentence()) + (11.8 * r.AverageSyllablesPerWord()) - 15.59
 }
 
 // GunningFogScore returns the Gunning-Fog score for the given text
 func (r *Results) GunningFogScore() float64 {
-	return (r.AverageWordsPerSentence() + r.PercentageWordsWithAtLeastNSyllables(3, false)) * 0.4
+	if r.Words == 0 {
+		return 0
+	}
+	complexWords := float64(r.ComplexWords) / float64(r.Words) * 100.0
+	return (r.AverageWordsPerSentence() + complexWords) * 0.4
 }
 
 // ColemanLiauIndex returns the Coleman-Liau index for the given text
 func (r *Results) ColemanLiauIndex() float64 {
+	if r.Words == 0 {
+		return 0
+	}
+
 	sentences := float64(r.Sentences)
 	if sentences == 0 {
 		sentences = 1
@@ -103,6 +214,10 @@ func (r *Results) ColemanLiauIndex() float64 {
 
 // SMOGIndex returns the SMOG index for the given text
 func (r *Results) SMOGIndex() float64 {
+	if r.Words == 0 {
+		return 0
+	}
+
 	sentences := float64(r.Sentences)
 	if sentences == 0 {
 		sentences = 1
@@ -113,6 +228,10 @@ func (r *Results) SMOGIndex() float64 {
 
 // AutomatedReadabilityIndex returns the Automated Readability index for the given text
 func (r *Results) AutomatedReadabilityIndex() float64 {
+	if r.Words == 0 {
+		return 0
+	}
+
 	sentences := float64(r.Sentences)
 	if sentences == 0 {
 		sentences = 1
@@ -123,6 +242,10 @@ func (r *Results) AutomatedReadabilityIndex() float64 {
 
 // DaleChallReadabilityScore returns the Dale-Chall readability score for the given text
 func (r *Results) DaleChallReadabilityScore() float64 {
+	if r.Words == 0 {
+		return 0
+	}
+
 	difficultyPercentage := (float64(r.DifficultWords) / float64(r.Words)) * 100
 
 	sentences := float64(r.Sentences)
@@ -138,122 +261,706 @@ func (r *Results) DaleChallReadabilityScore() float64 {
 	return score
 }
 
-func syllableCount(word string) (sCount int) {
-	word = strings.ToLower(word)
+func analyseWord(letters, sCount int, difficult, properNoun, complexWord bool, res *Results) {
+	res.Words++
+	res.Syllables += sCount
 
-	// return early if we have a problem word
-	sCount, ok := ProblemWords[word]
-	if ok {
-		return
+	if _, ok := res.syllableWords[sCount]; ok {
+		res.syllableWords[sCount]++
+	} else {
+		res.syllableWords[sCount] = 1
 	}
 
-	var prefixSuffixCount int
-	for _, regex := range PrefixSuffixes[:] {
-		if regex.MatchString(word) {
-			word = regex.ReplaceAllString(word, "")
-			prefixSuffixCount++
+	if properNoun {
+		if _, ok := res.syllableProperNouns[sCount]; ok {
+			res.syllableProperNouns[sCount]++
+		} else {
+			res.syllableProperNouns[sCount] = 1
 		}
 	}
 
-	var wordPartCount int
-	for _, wordPart := range consonantsRegexp.Split(word, -1) {
-		if len(wordPart) > 0 {
-			wordPartCount++
+	if difficult {
+		res.DifficultWords++
+	}
+
+	if complexWord {
+		res.ComplexWords++
+	}
+
+	res.letterWords[letters]++
+}
+
+// singular returns the first run of ASCII letters in a word without a final
+// "s", or nil if it has none
+func singular(word []byte) []byte {
+	start := 0
+	for start < len(word) && !isASCIILetter(word[start]) {
+		start++
+	}
+	end := start
+	for end < len(word) && isASCIILetter(word[end]) {
+		end++
+	}
+
+	switch {
+	case start == end:
+		return nil
+	case end-start > 1 && word[end-1] == 's':
+		end--
+	}
+	return word[start:end]
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// appendLower appends text to dst in lower case
+func appendLower(dst, text []byte) []byte {
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		i += size
+		dst = utf8.AppendRune(dst, unicode.ToLower(r))
+	}
+	return dst
+}
+
+// analyser accumulates Results from a stream of tokens
+type analyser struct {
+	cfg *config
+	res *Results
+	// para holds the results for the current paragraph when paragraph
+	// details are being recorded, and is res otherwise. cur likewise holds
+	// the results for the current sentence, and is para otherwise.
+	para, cur *Results
+	seg       segmenter
+	last      TokenKind
+
+	// letters and parts are reused for the letters of each word token, and
+	// lower and runes for each word in lower case. folded holds a complex
+	// word candidate in lower case while lower is reused for its parts, and
+	// familiar holds a contraction in lower case while it is looked up in
+	// the familiar words.
+	letters  []byte
+	parts    []wordPart
+	lower    []byte
+	runes    []rune
+	folded   []byte
+	familiar []byte
+	// rules are the matchers for cfg.syllables if it is a *SyllableRules, so
+	// that words can be counted without making strings of them
+	rules *ruleMatchers
+
+	// offset and next are the byte offsets of the current and next tokens
+	offset, next int
+	// spelled is true while the words a TokenRule made are being added
+	spelled bool
+	// words is the number of words in the current sentence, and total the
+	// number in the whole text
+	words, total int
+
+	// start and end are the byte offsets of the current sentence, and
+	// nextStart is where the next one will start if the sentence ends.
+	// paraStart and paraEnd are the byte offsets of the current paragraph,
+	// and paraWords is true once it has any words. text holds the text from
+	// base onwards.
+	start, end, nextStart int
+	paraStart, paraEnd    int
+	paraWords             bool
+	text                  []byte
+	base                  int
+}
+
+func newAnalyser(cfg *config) *analyser {
+	a := &analyser{
+		cfg: cfg,
+		res: newResults(),
+		seg: segmenter{
+			abbreviations: cfg.abbreviations,
+			terminators:   cfg.terminators,
+		},
+		last:      SpaceToken,
+		start:     -1,
+		nextStart: -1,
+		paraStart: -1,
+	}
+
+	a.para = a.res
+	if cfg.paragraphDetails {
+		a.para = newResults()
+	}
+	a.cur = a.para
+	if cfg.sentenceDetails {
+		a.cur = newResults()
+	}
+
+	if rules, ok := cfg.syllables.(*SyllableRules); ok {
+		a.rules = rules.matchers()
+	}
+
+	return a
+}
+
+// token adds a single token to the analysis
+func (a *analyser) token(kind TokenKind, text []byte) {
+	// Mentions and hashtags only start a word, so "a@b" is two words
+	if kind == MentionToken || kind == HashtagToken {
+		switch a.last {
+		case WordToken, NumberToken, URLToken, EmailToken, MentionToken, HashtagToken:
+			a.token(PunctToken, text[:1])
+			a.token(WordToken, text[1:])
+			return
 		}
 	}
+	a.last = kind
+	a.offset = a.next
+	a.next += len(text)
+	if a.cfg.sentenceDetails || a.cfg.paragraphDetails {
+		a.text = append(a.text, text...)
+	}
 
-	sCount = wordPartCount + prefixSuffixCount
+	window := a.seg.pending && a.seg.spaced
+	ended := a.seg.token(kind, text)
+	if ended {
+		a.countSentence()
+	}
+	if a.cfg.sentenceDetails {
+		a.sentence(kind, window, ended)
+	}
+	if a.cfg.paragraphDetails && kind != SpaceToken {
+		if a.paraStart < 0 {
+			a.paraStart = a.offset
+		}
+		a.paraEnd = a.next
+	}
 
-	for _, regex := range SubSyllables[:] {
-		if regex.MatchString(word) {
-			sCount--
+	a.cur.Bytes += len(text)
+	switch kind {
+	case WordToken:
+		a.word(text)
+	case SpaceToken:
+		a.cur.Spaces += utf8.RuneCount(text)
+		if a.cfg.paragraphs != nil && a.cfg.paragraphs(text) {
+			a.endParagraph()
 		}
+	case PunctToken:
+		a.cur.Punctuation += utf8.RuneCount(text)
+	case NumberToken, URLToken, EmailToken, MentionToken, HashtagToken:
+		a.entity(kind, text)
+	}
+}
+
+// entity adds a number, URL, email address, mention or hashtag to the
+// analysis, counting it as whatever words its TokenRule gives
+func (a *analyser) entity(kind TokenKind, text []byte) {
+	switch kind {
+	case NumberToken:
+		a.cur.Numbers++
+	case URLToken:
+		a.cur.URLs++
+	case EmailToken:
+		a.cur.Emails++
+	case MentionToken:
+		a.cur.Mentions++
+	case HashtagToken:
+		a.cur.Hashtags++
 	}
 
-	for _, regex := range AddSyllables[:] {
-		if regex.MatchString(word) {
-			sCount++
+	if rule := a.cfg.tokenRules[kind]; rule != nil {
+		// Only the words count, not any hyphens between them
+		punct := a.cur.Punctuation
+		a.spelled = true
+		for _, word := range rule(string(text)) {
+			a.word([]byte(word))
 		}
+		a.spelled = false
+		a.cur.Punctuation = punct
 	}
+}
 
-	return
+// word adds a word token to the analysis. Only letters make up the word, so
+// joiners such as the apostrophe in "you'll" are dropped before syllable
+// counting and word list lookups.
+func (a *analyser) word(text []byte) {
+	letters, parts, hyphens := wordParts(text, a.letters[:0], a.parts[:0])
+	a.letters, a.parts = letters, parts
+	if len(parts) == 0 {
+		return
+	}
+
+	a.cur.Letters += utf8.RuneCount(letters)
+
+	if len(parts) == 1 || a.cfg.hyphens == HyphenSplit {
+		a.cur.Punctuation += hyphens
+		a.seg.words += len(parts)
+		for _, part := range parts {
+			word := letters[part.start:part.end]
+			a.analyseWord(word, a.wordOffset(part), a.syllables(word), a.isPartDifficult(word, part), false)
+		}
+		return
+	}
+
+	// A compound is only difficult if one of its parts is, and only complex
+	// if one of its parts is
+	var sCount int
+	var difficult bool
+	simple := true
+	for _, part := range parts {
+		word := letters[part.start:part.end]
+		partCount := a.syllables(word)
+		sCount += partCount
+		difficult = difficult || a.isPartDifficult(word, part)
+		simple = simple && partCount < 3
+	}
+
+	// The parts follow each other in letters, which is the compound without
+	// its hyphens
+	if a.cfg.hyphens == HyphenJoin {
+		sCount = a.syllables(letters)
+	}
+
+	a.seg.words++
+	a.analyseWord(letters, a.wordOffset(parts[0]), sCount, difficult, simple)
 }
 
-func analyseWord(word string, res *Results) {
-	res.Words++
+// wordOffset returns the byte offset in the text of a part of the current
+// token
+func (a *analyser) wordOffset(part wordPart) int {
+	if a.spelled {
+		return a.offset
+	}
+	return a.offset + part.offset
+}
 
-	sCount := syllableCount(word)
-	res.Syllables += sCount
+// syllables returns the number of syllables in a word
+func (a *analyser) syllables(word []byte) int {
+	d := a.cfg.dictionary
+	if d == nil && a.rules == nil {
+		return a.cfg.syllables.Syllables(string(word))
+	}
 
-	if _, ok := res.syllableWords[sCount]; ok {
-		res.syllableWords[sCount]++
-	} else {
-		res.syllableWords[sCount] = 1
+	a.lower = appendLower(a.lower[:0], word)
+
+	var removed bool
+	if d != nil {
+		count, ok, isRemoved := d.syllables(a.lower)
+		if ok {
+			return count
+		}
+		removed = isRemoved
 	}
 
-	if l, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(l) {
-		if _, ok := res.syllableProperNouns[sCount]; ok {
-			res.syllableProperNouns[sCount]++
-		} else {
-			res.syllableProperNouns[sCount] = 1
+	if a.rules == nil {
+		return a.cfg.syllables.Syllables(string(word))
+	}
+
+	// Skip the built in problem words too if the dictionary removed the word
+	if !removed {
+		if count, ok := a.cfg.syllables.(*SyllableRules).ProblemWords[string(a.lower)]; ok {
+			return count
 		}
 	}
 
-	if _, ok := DaleChallWordList[word]; !ok {
-		matches := pluralRegexp.FindStringSubmatch(word)
-		if len(matches) >= 2 {
-			if _, ok := DaleChallWordList[matches[1]]; !ok {
-				res.DifficultWords++
-			}
-		} else {
-			res.DifficultWords++
+	a.runes = a.runes[:0]
+	for i := 0; i < len(a.lower); {
+		r, size := utf8.DecodeRune(a.lower[i:])
+		i += size
+		a.runes = append(a.runes, r)
+	}
+	return a.rules.heuristic(a.runes)
+}
+
+// isPartDifficult reports whether a part of a word token is difficult.
+// Contractions and possessives are looked up in lower case, as the word
+// lists hold them, so that "You'll" matches "youll". Other words are looked
+// up as they are written.
+func (a *analyser) isPartDifficult(word []byte, part wordPart) bool {
+	if part.apostrophe {
+		a.familiar = appendLower(a.familiar[:0], word)
+		word = a.familiar
+	}
+	return a.isDifficult(word)
+}
+
+// isDifficult reports whether neither a word nor its singular are familiar
+func (a *analyser) isDifficult(word []byte) bool {
+	if a.isFamiliar(word) {
+		return false
+	}
+
+	singular := singular(word)
+	return singular == nil || !a.isFamiliar(singular)
+}
+
+// isFamiliar reports whether a word is on the familiar word list
+func (a *analyser) isFamiliar(word []byte) bool {
+	if d := a.cfg.dictionary; d != nil {
+		if familiar, ok := d.familiar(word); ok {
+			return familiar
 		}
 	}
+
+	_, ok := a.cfg.familiar[string(word)]
+	return ok
 }
 
-// Analyse scans a reader and outputs an analysis
-func Analyse(r io.Reader) (res *Results, err error) {
-	scanner := bufio.NewScanner(r)
-	scanner.Split(bufio.ScanRunes)
-	res = &Results{}
-	res.syllableWords = make(map[int]int)
-	res.syllableProperNouns = make(map[int]int)
-
-	var word string
-	var endWord bool
-	for scanner.Scan() {
-		str := scanner.Text()
-		letter, _ := utf8.DecodeRuneInString(str)
-		switch {
-		case unicode.IsLetter(letter):
-			res.Letters++
-			word += str
-			endWord = false
-		case unicode.IsSpace(letter):
-			endWord = true
-			res.Spaces++
-		case unicode.IsPunct(letter):
-			endWord = true
-			switch str {
-			case ".", "!", "?":
-				res.Sentences++
-			}
-			res.Punctuation++
+// properNoun reports whether a word is treated as a proper noun or an
+// acronym. Unless WithProperNouns or WithCapitalisedProperNouns replaced the
+// rule, or ComplexWordsLegacy restored the earlier one, capitalised words are
+// proper nouns, apart from single letters, words in capitals, and the first
+// word of a sentence if it isn't a proper noun in the Lexicon, or there is no
+// Lexicon. Words in capitals are acronyms unless they are in the Lexicon.
+func (a *analyser) properNoun(word []byte, first bool) (properNoun, acronym bool) {
+	capitals := isCapitals(word)
+	if capitals {
+		acronym = !a.inLexicon(word)
+	}
+
+	r, size := utf8.DecodeRune(word)
+	switch {
+	case a.cfg.customProperNouns:
+		properNoun = a.customProperNoun(word)
+	case a.cfg.complexWords == ComplexWordsLegacy:
+		properNoun = unicode.IsUpper(r)
+	case capitals, !unicode.IsUpper(r), size == len(word):
+	case first:
+		properNoun = a.cfg.lexicon != nil && !a.inLexicon(word)
+	default:
+		properNoun = true
+	}
+
+	return properNoun, acronym
+}
+
+// customProperNoun reports whether the function set by WithProperNouns or
+// WithCapitalisedProperNouns treats a word as a proper noun
+func (a *analyser) customProperNoun(word []byte) bool {
+	switch {
+	case a.cfg.properNoun == nil:
+		return false
+	case a.cfg.capitalised:
+		r, _ := utf8.DecodeRune(word)
+		return unicode.IsUpper(r)
+	}
+	return a.cfg.properNoun(string(word))
+}
+
+// inLexicon reports whether the lower case form of a word is in the Lexicon
+func (a *analyser) inLexicon(word []byte) bool {
+	if a.cfg.lexicon == nil {
+		return false
+	}
+	a.lower = appendLower(a.lower[:0], word)
+	_, ok := a.cfg.lexicon[string(a.lower)]
+	return ok
+}
+
+// isCapitals reports whether a word has at least two letters, all of them in
+// upper case
+func isCapitals(word []byte) bool {
+	var letters int
+	for _, r := range string(word) {
+		if !unicode.IsUpper(r) {
+			return false
 		}
+		letters++
+	}
+	return letters > 1
+}
+
+// analyseWord adds a single word to the results. compound is true if the word
+// is a hyphenated compound of simpler words.
+func (a *analyser) analyseWord(word []byte, offset, sCount int, difficult, compound bool) {
+	properNoun, acronym := a.properNoun(word, a.words == 0)
+	complexWord := a.isComplex(word, sCount, properNoun, difficult, compound)
+	analyseWord(utf8.RuneCount(word), sCount, difficult, properNoun, complexWord, a.cur)
+	if acronym {
+		a.cur.Acronyms++
+	}
+
+	a.words++
+	a.total++
+	a.paraWords = true
+	if a.cfg.wordDetails {
+		a.cur.WordDetails = append(a.cur.WordDetails, WordResult{
+			Word:       string(word),
+			Offset:     offset,
+			Syllables:  sCount,
+			ProperNoun: properNoun,
+			Acronym:    acronym,
+			Difficult:  difficult,
+			Complex:    complexWord,
+		})
+	}
+}
+
+// sentence tracks the span of the current sentence, closing it if it ended
+// before this token. window is true if the token followed the whitespace after
+// a terminator, where opening quotes and brackets belong to the next sentence.
+func (a *analyser) sentence(kind TokenKind, window, ended bool) {
+	if kind == SpaceToken {
+		return
+	}
+
+	if window && a.nextStart < 0 {
+		a.nextStart = a.offset
+	}
+
+	if ended {
+		a.closeSentence(a.nextStart)
+	}
 
-		if endWord && len(word) > 0 {
-			analyseWord(word, res)
-			endWord = false
-			word = ""
+	if !a.seg.pending || !a.seg.spaced {
+		if a.start < 0 {
+			a.start = a.offset
 		}
+		a.end = a.next
+		a.nextStart = -1
+	}
+}
+
+// countSentence counts a sentence that has just ended
+func (a *analyser) countSentence() {
+	a.para.Sentences++
+	a.cur.sentenceWords[a.words]++
+	a.words = 0
+}
+
+// closeSentence records the current sentence and starts the next one at the
+// given offset, or at the next token if it is -1
+func (a *analyser) closeSentence(next int) {
+	sentence := SentenceResult{
+		Start:   a.start,
+		End:     a.end,
+		Text:    string(a.text[a.start-a.base : a.end-a.base]),
+		Results: *a.cur,
 	}
+	sentence.Sentences = 1
+	a.para.SentenceDetails = append(a.para.SentenceDetails, sentence)
 
-	if len(word) > 0 {
-		analyseWord(word, res)
+	a.para.add(a.cur)
+	a.cur = newResults()
+	a.start = next
+
+	// The paragraph's text is still needed if it is being recorded
+	if !a.cfg.paragraphDetails {
+		a.trimText(next)
 	}
+}
+
+// endSentence ends the current sentence at a paragraph break or the end of
+// the text
+func (a *analyser) endSentence() {
+	ended := a.seg.finish()
+	if ended {
+		a.countSentence()
+	}
+
+	if a.cur == a.para {
+		return
+	}
+
+	if ended {
+		a.closeSentence(-1)
+		return
+	}
+
+	// Keep any punctuation that followed the last sentence, but not its
+	// span, as no sentence is open
+	a.para.add(a.cur)
+	a.cur = newResults()
+	a.start, a.nextStart = -1, -1
+}
+
+// endParagraph ends the current sentence and paragraph at a paragraph break
+// or the end of the text
+func (a *analyser) endParagraph() {
+	a.endSentence()
+	words := a.paraWords
+	a.paraWords = false
+
+	if words {
+		a.res.Paragraphs++
+	}
+	if a.para == a.res {
+		return
+	}
+
+	// A paragraph without words isn't recorded, but it still ends at the
+	// break and its counts go towards the totals
+	if words {
+		paragraph := ParagraphResult{
+			Start:   a.paraStart,
+			End:     a.paraEnd,
+			Text:    string(a.text[a.paraStart-a.base : a.paraEnd-a.base]),
+			Results: *a.para,
+		}
+		paragraph.Paragraphs = 1
+		a.res.ParagraphDetails = append(a.res.ParagraphDetails, paragraph)
+	}
+
+	a.res.add(a.para)
+	a.para = newResults()
+	if !a.cfg.sentenceDetails {
+		a.cur = a.para
+	}
+	a.paraStart = -1
+	a.trimText(-1)
+}
+
+// trimText drops the recorded text before an offset, or all of it if the
+// offset is -1
+func (a *analyser) trimText(offset int) {
+	if offset < 0 {
+		offset = a.next
+	}
+	a.text = append(a.text[:0], a.text[offset-a.base:]...)
+	a.base = offset
+}
+
+// limit returns a LimitError once the text has gone past a limit
+func (a *analyser) limit() error {
+	switch {
+	case a.cfg.maxBytes > 0 && int64(a.next) > a.cfg.maxBytes:
+		return &LimitError{Limit: BytesLimit, Max: a.cfg.maxBytes}
+	case a.cfg.maxWords > 0 && a.total > a.cfg.maxWords:
+		return &LimitError{Limit: WordsLimit, Max: int64(a.cfg.maxWords)}
+	}
+	return nil
+}
+
+// finish completes the analysis once all tokens have been seen
+func (a *analyser) finish() {
+	a.endParagraph()
+
+	// Anything left has no words, but still counts towards the totals
+	if a.para != a.res {
+		a.res.add(a.para)
+		a.para = a.res
+	}
+	a.cur = a.res
+}
+
+// ErrNoWords is returned by Analyse, along with the Results, when the text
+// doesn't contain any words to score
+var ErrNoWords = errors.New("textstats: no words in text")
+
+// Limit is a limit on the work an analysis can do
+type Limit string
+
+// The limits that can be set for an analysis
+const (
+	BytesLimit    Limit = "bytes"
+	WordsLimit    Limit = "words"
+	DurationLimit Limit = "duration"
+)
+
+// LimitError is returned, along with the Results so far, when an analysis
+// stops because it reached a limit set with WithMaxBytes, WithMaxWords or
+// WithTimeout
+type LimitError struct {
+	// Limit is the limit that was reached
+	Limit Limit
+
+	// Max is the value of the limit, in bytes, words or as a time.Duration
+	Max int64
+}
+
+// Error implements error
+func (e *LimitError) Error() string {
+	if e.Limit == DurationLimit {
+		return fmt.Sprintf("textstats: analysis took longer than %s", time.Duration(e.Max))
+	}
+	return fmt.Sprintf("textstats: text has more than %d %s", e.Max, e.Limit)
+}
+
+// Unwrap returns context.DeadlineExceeded for a DurationLimit
+func (e *LimitError) Unwrap() error {
+	if e.Limit == DurationLimit {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+// contextReader stops reading once its context is done
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read implements io.Reader
+func (c contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// Analyse scans a reader and outputs an analysis. Options change how the text
+// is analysed without affecting any other analysis. If the text has no words,
+// the Results are returned with ErrNoWords.
+func Analyse(r io.Reader, opts ...Option) (res *Results, err error) {
+	return AnalyseContext(context.Background(), r, opts...)
+}
+
+// AnalyseContext is like Analyse, but stops once ctx is done, returning the
+// Results so far with the context's error. A Read from r that is already
+// blocked can't be stopped.
+func AnalyseContext(ctx context.Context, r io.Reader, opts ...Option) (*Results, error) {
+	return analyse(ctx, r, newConfig(opts))
+}
+
+func analyse(parent context.Context, r io.Reader, cfg *config) (*Results, error) {
+	ctx := parent
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parent, cfg.timeout)
+		defer cancel()
+	}
+
+	// Read one byte more than the limit, to tell if the text is too long
+	if cfg.maxBytes > 0 {
+		r = io.LimitReader(r, cfg.maxBytes+1)
+	}
+
+	a := newAnalyser(cfg)
+
+	var kind TokenKind
+	scanner := bufio.NewScanner(contextReader{ctx, r})
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		n, k, err := nextToken(cfg.tokenizer, data, atEOF)
+		if n == 0 {
+			return 0, nil, err
+		}
+		kind = k
+		return n, data[:n], err
+	})
+
+	var err error
+	done := ctx.Done()
+	for err == nil && scanner.Scan() {
+		a.token(kind, scanner.Bytes())
+
+		select {
+		case <-done:
+			err = ctx.Err()
+		default:
+			err = a.limit()
+		}
+	}
+	a.finish()
 
 	// Return scanner error if any
-	err = scanner.Err()
+	if err == nil {
+		err = scanner.Err()
+	}
+
+	switch {
+	case err == nil && a.res.Words == 0:
+		err = ErrNoWords
+	case err != nil && err == ctx.Err() && parent.Err() == nil:
+		err = &LimitError{Limit: DurationLimit, Max: int64(cfg.timeout)}
+	}
 
-	return
+	return a.res, err
 }
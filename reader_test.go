@@ -1,9 +1,12 @@
 package textstats
 
 import (
+	"context"
 	"errors"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -21,6 +24,123 @@ func (b *badReader) Read(buf []byte) (int, error) {
 func (s *AnalyseSuite) TestBadReader() {
 	_, err := Analyse(&badReader{})
 	s.Error(err)
+	s.NotEqual(ErrNoWords, err)
+}
+
+func (s *AnalyseSuite) TestNoWords() {
+	for _, text := range []string{"", "   ", "... !?", "1999 http://example.com"} {
+		res, err := Analyse(strings.NewReader(text))
+		s.Equal(ErrNoWords, err, "%q", text)
+		s.Require().NotNil(res)
+		s.Equal(0, res.Words)
+
+		scores := []float64{
+			res.AverageLettersPerWord(),
+			res.AverageSyllablesPerWord(),
+			res.AverageWordsPerSentence(),
+			res.PercentageWordsWithAtLeastNSyllables(3, true),
+			res.FleschKincaidReadingEase(),
+			res.FleschKincaidGradeLevel(),
+			res.GunningFogScore(),
+			res.ColemanLiauIndex(),
+			res.SMOGIndex(),
+			res.AutomatedReadabilityIndex(),
+			res.DaleChallReadabilityScore(),
+		}
+		for i, score := range scores {
+			s.Equal(0.0, score, "%q score %d", text, i)
+		}
+	}
+
+	_, err := Analyse(strings.NewReader(hw))
+	s.NoError(err)
+}
+
+// endlessReader returns the same words forever
+type endlessReader struct{}
+
+func (e endlessReader) Read(buf []byte) (int, error) {
+	return copy(buf, "All work and no play makes Jack a dull boy. "), nil
+}
+
+func (s *AnalyseSuite) TestContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := AnalyseContext(ctx, strings.NewReader(qbf))
+	s.Equal(context.Canceled, err)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	res, err := AnalyseContext(ctx, endlessReader{})
+	s.Equal(context.Canceled, err)
+	s.True(res.Words > 0)
+
+	res, err = AnalyseContext(context.Background(), strings.NewReader(qbf))
+	s.NoError(err)
+	s.Equal(9, res.Words)
+}
+
+func (s *AnalyseSuite) TestLimits() {
+	res, err := Analyse(endlessReader{}, WithTimeout(20*time.Millisecond))
+	s.Equal(&LimitError{Limit: DurationLimit, Max: int64(20 * time.Millisecond)}, err)
+	s.True(errors.Is(err, context.DeadlineExceeded))
+	s.Equal("textstats: analysis took longer than 20ms", err.Error())
+	s.True(res.Words > 0)
+
+	res, err = Analyse(endlessReader{}, WithMaxBytes(1000))
+	s.Equal(&LimitError{Limit: BytesLimit, Max: 1000}, err)
+	s.False(errors.Is(err, context.DeadlineExceeded))
+	s.Equal("textstats: text has more than 1000 bytes", err.Error())
+	s.True(res.Bytes <= 1001)
+
+	res, err = Analyse(endlessReader{}, WithMaxWords(50))
+	s.Equal(&LimitError{Limit: WordsLimit, Max: 50}, err)
+	s.Equal(51, res.Words)
+
+	_, err = Analyse(strings.NewReader(qbf), WithMaxBytes(int64(len(qbf))), WithMaxWords(9), WithTimeout(time.Minute))
+	s.NoError(err)
+}
+
+func (s *AnalyseSuite) TestParallelLimits() {
+	text := strings.Repeat(qbf+".\n\n", 100)
+	size := int64(len(text))
+
+	res, err := AnalyseParallel(strings.NewReader(text), size, WithMaxBytes(size-1))
+	s.Equal(&LimitError{Limit: BytesLimit, Max: size - 1}, err)
+	s.Nil(res)
+
+	// The chunks still to be analysed are stopped once the limit is passed
+	res, err = AnalyseParallel(strings.NewReader(text), size, WithMaxWords(100), WithChunkSize(100), WithWorkers(2))
+	s.Equal(&LimitError{Limit: WordsLimit, Max: 100}, err)
+	s.Greater(res.Words, 100)
+	s.Less(res.Words, 200)
+
+	// A single chunk stops as Analyse does
+	res, err = AnalyseParallel(strings.NewReader(text), size, WithMaxWords(100))
+	s.Equal(&LimitError{Limit: WordsLimit, Max: 100}, err)
+	s.Equal(101, res.Words)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = AnalyseParallelContext(ctx, strings.NewReader(text), size, WithChunkSize(100))
+	s.Equal(context.Canceled, err)
+
+	_, err = AnalyseParallel(strings.NewReader(text), size, WithMaxBytes(size), WithTimeout(time.Minute))
+	s.NoError(err)
+}
+
+func (s *AnalyseSuite) TestLongRuns() {
+	res, err := Analyse(strings.NewReader(strings.Repeat(" ", 70000) + "Hello world"))
+	s.NoError(err)
+	s.Equal(2, res.Words)
+	s.Equal(70001, res.Spaces)
+
+	// Runs of letters longer than the buffer are split into several words,
+	// without splitting any rune
+	res, err = Analyse(strings.NewReader(strings.Repeat("é", 70000)))
+	s.NoError(err)
+	s.Equal(3, res.Words)
+	s.Equal(70000, res.Letters)
 }
 
 func (s *AnalyseSuite) TestAverageLettersPerWord() {
@@ -50,9 +170,9 @@ func (s *AnalyseSuite) TestWordsWithAtLeastNSyllables() {
 	s.Equal(1, res.WordsWithAtLeastNSyllables(4, true))
 	s.Equal(0, res.WordsWithAtLeastNSyllables(5, true))
 
-	s.Equal(4, res.WordsWithAtLeastNSyllables(0, false))
-	s.Equal(4, res.WordsWithAtLeastNSyllables(1, false))
-	s.Equal(2, res.WordsWithAtLeastNSyllables(2, false))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(0, false))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(1, false))
+	s.Equal(3, res.WordsWithAtLeastNSyllables(2, false))
 	s.Equal(2, res.WordsWithAtLeastNSyllables(3, false))
 	s.Equal(1, res.WordsWithAtLeastNSyllables(4, false))
 	s.Equal(0, res.WordsWithAtLeastNSyllables(5, false))
@@ -67,10 +187,10 @@ func (s *AnalyseSuite) TestPercentageWordsWithAtLeastNSyllables() {
 	s.Equal(16.666666666666664, res.PercentageWordsWithAtLeastNSyllables(4, true))
 	s.Equal(0.0, res.PercentageWordsWithAtLeastNSyllables(5, true))
 
-	s.Equal(66.66666666666666, res.PercentageWordsWithAtLeastNSyllables(0, false))
-	s.Equal(66.66666666666666, res.PercentageWordsWithAtLeastNSyllables(1, false))
+	s.Equal(83.33333333333334, res.PercentageWordsWithAtLeastNSyllables(0, false))
+	s.Equal(83.33333333333334, res.PercentageWordsWithAtLeastNSyllables(1, false))
 	s.Equal(33.33333333333333, res.PercentageWordsWithAtLeastNSyllables(3, false))
-	s.Equal(33.33333333333333, res.PercentageWordsWithAtLeastNSyllables(2, false))
+	s.Equal(50.0, res.PercentageWordsWithAtLeastNSyllables(2, false))
 	s.Equal(16.666666666666664, res.PercentageWordsWithAtLeastNSyllables(4, false))
 	s.Equal(0.0, res.PercentageWordsWithAtLeastNSyllables(5, false))
 }
@@ -80,6 +200,85 @@ func (s *AnalyseSuite) TestWordCount() {
 	s.Equal(9, res.Words)
 }
 
+func (s *AnalyseSuite) TestContractions() {
+	res, _ := Analyse(strings.NewReader("You'll see you’re right, don't worry."))
+	s.Equal(6, res.Words)
+	s.Equal(2, res.Punctuation)
+	s.Equal(27, res.Letters)
+
+	// Contractions are familiar whatever their case, but other words are
+	// looked up as written
+	s.Equal(0, res.DifficultWords)
+	res, _ = Analyse(strings.NewReader("YOU'LL Worry"))
+	s.Equal(1, res.DifficultWords)
+}
+
+func (s *AnalyseSuite) TestWordDetails() {
+	res, _ := Analyse(strings.NewReader(hw))
+	s.Nil(res.WordDetails)
+
+	text := "Jo Smith’s well-known dog barks about 2 times."
+	res, _ = Analyse(strings.NewReader(text), WithWordDetails(), WithTokenRule(NumberToken, SpellNumber))
+	s.Equal([]WordResult{
+		{Word: "Jo", Offset: 0, Syllables: 1, Difficult: true},
+		{Word: "Smiths", Offset: 3, Syllables: 1, ProperNoun: true, Difficult: true},
+		{Word: "well", Offset: 13, Syllables: 1},
+		{Word: "known", Offset: 18, Syllables: 1},
+		{Word: "dog", Offset: 24, Syllables: 1},
+		{Word: "barks", Offset: 28, Syllables: 1},
+		{Word: "about", Offset: 34, Syllables: 2},
+		{Word: "two", Offset: 40, Syllables: 1},
+		{Word: "times", Offset: 42, Syllables: 1},
+	}, res.WordDetails)
+	s.Equal(res.Words, len(res.WordDetails))
+
+	res, _ = Analyse(strings.NewReader(text), WithWordDetails(), WithHyphenMode(HyphenJoin))
+	s.Equal(WordResult{Word: "wellknown", Offset: 13, Syllables: 2}, res.WordDetails[2])
+}
+
+func (s *AnalyseSuite) TestProperNouns() {
+	flags := func(res *Results) map[string][2]bool {
+		words := make(map[string][2]bool)
+		for _, w := range res.WordDetails {
+			words[w.Word] = [2]bool{w.ProperNoun, w.Acronym}
+		}
+		return words
+	}
+
+	text := "Yesterday Alice visited NASA. ANNUAL REPORT\n\nParis is lovely, said I."
+	res, _ := Analyse(strings.NewReader(text), WithWordDetails())
+	s.Equal(map[string][2]bool{
+		"Yesterday": {false, false},
+		"Alice":     {true, false},
+		"visited":   {false, false},
+		"NASA":      {false, true},
+		"ANNUAL":    {false, true},
+		"REPORT":    {false, true},
+		"Paris":     {false, false},
+		"is":        {false, false},
+		"lovely":    {false, false},
+		"said":      {false, false},
+		"I":         {false, false},
+	}, flags(res))
+	s.Equal(3, res.Acronyms)
+	s.Equal(3, res.WordsWithAtLeastNSyllables(3, false))
+
+	res, _ = Analyse(strings.NewReader(text), WithWordDetails(), WithLexicon(NewLexicon("yesterday", "annual", "report")))
+	words := flags(res)
+	s.Equal([2]bool{false, false}, words["Yesterday"])
+	s.Equal([2]bool{true, false}, words["Paris"])
+	s.Equal([2]bool{false, false}, words["ANNUAL"])
+	s.Equal([2]bool{false, true}, words["NASA"])
+	s.Equal(1, res.Acronyms)
+
+	res, _ = Analyse(strings.NewReader(text), WithWordDetails(), WithProperNouns(IsCapitalised))
+	words = flags(res)
+	s.Equal([2]bool{true, false}, words["Yesterday"])
+	s.Equal([2]bool{true, true}, words["ANNUAL"])
+	s.Equal(3, res.Acronyms)
+	s.Equal(1, res.WordsWithAtLeastNSyllables(3, false))
+}
+
 func (s *AnalyseSuite) TestLetterCount() {
 	res, _ := Analyse(strings.NewReader(qbf))
 	s.Equal(35, res.Letters)
@@ -107,7 +306,7 @@ func (s *AnalyseSuite) TestFleschKincaidGradeLevel() {
 
 func (s *AnalyseSuite) TestGunningFogScore() {
 	res, _ := Analyse(strings.NewReader(lorem))
-	s.Equal(19.073913043478264, res.GunningFogScore())
+	s.Equal(19.653623188405795, res.GunningFogScore())
 }
 
 func (s *AnalyseSuite) TestColemanLiauIndex() {
@@ -133,3 +332,42 @@ func (s *AnalyseSuite) TestDaleChallReadabilityScore() {
 func TestAnalyseMethods(t *testing.T) {
 	suite.Run(t, new(AnalyseSuite))
 }
+
+// benchmarkAnalyse analyses text b.N times, reporting the allocations per word
+// as well as per analysis
+func benchmarkAnalyse(b *testing.B, text string, opts ...Option) {
+	res, _ := Analyse(strings.NewReader(text), opts...)
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Analyse(strings.NewReader(text), opts...)
+	}
+	b.StopTimer()
+	runtime.ReadMemStats(&after)
+
+	b.ReportMetric(float64(after.Mallocs-before.Mallocs)/float64(b.N*res.Words), "allocs/word")
+}
+
+func BenchmarkAnalyse(b *testing.B) {
+	text := strings.Repeat(lorem+"\n\n"+qbf+". Dr. Smith's well-known, re-examined cafés aren't fun!\n\n", 50)
+
+	b.Run("Default", func(b *testing.B) {
+		benchmarkAnalyse(b, text)
+	})
+	b.Run("Dictionary", func(b *testing.B) {
+		d := NewDictionary()
+		d.RemoveWord("forest")
+		d.AddFamiliarWord("lorem")
+		benchmarkAnalyse(b, text, WithDictionary(d))
+	})
+	b.Run("HyphenJoin", func(b *testing.B) {
+		benchmarkAnalyse(b, text, WithHyphenMode(HyphenJoin))
+	})
+	b.Run("Short", func(b *testing.B) {
+		benchmarkAnalyse(b, qbf)
+	})
+}
@@ -0,0 +1,30 @@
+package textstats
+
+// ScoreSet holds every readability score for a text
+type ScoreSet struct {
+	FleschKincaidReadingEase  float64 `json:"flesch_kincaid_reading_ease" yaml:"flesch_kincaid_reading_ease"`
+	FleschKincaidGradeLevel   float64 `json:"flesch_kincaid_grade_level" yaml:"flesch_kincaid_grade_level"`
+	GunningFogScore           float64 `json:"gunning_fog_score" yaml:"gunning_fog_score"`
+	ColemanLiauIndex          float64 `json:"coleman_liau_index" yaml:"coleman_liau_index"`
+	SMOGIndex                 float64 `json:"smog_index" yaml:"smog_index"`
+	AutomatedReadabilityIndex float64 `json:"automated_readability_index" yaml:"automated_readability_index"`
+	DaleChallReadabilityScore float64 `json:"dale_chall_readability_score" yaml:"dale_chall_readability_score"`
+}
+
+// AllScores returns every readability score for the text, calculated from
+// the one analysis
+func (r *Results) AllScores() ScoreSet {
+	if r.Words == 0 {
+		return ScoreSet{}
+	}
+
+	return ScoreSet{
+		FleschKincaidReadingEase:  r.FleschKincaidReadingEase(),
+		FleschKincaidGradeLevel:   r.FleschKincaidGradeLevel(),
+		GunningFogScore:           r.GunningFogScore(),
+		ColemanLiauIndex:          r.ColemanLiauIndex(),
+		SMOGIndex:                 r.SMOGIndex(),
+		AutomatedReadabilityIndex: r.AutomatedReadabilityIndex(),
+		DaleChallReadabilityScore: r.DaleChallReadabilityScore(),
+	}
+}
@@ -0,0 +1,33 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScoresSuite struct {
+	suite.Suite
+}
+
+func (s *ScoresSuite) TestAllScores() {
+	res, _ := Analyse(strings.NewReader(lorem))
+	s.Equal(ScoreSet{
+		FleschKincaidReadingEase:  res.FleschKincaidReadingEase(),
+		FleschKincaidGradeLevel:   res.FleschKincaidGradeLevel(),
+		GunningFogScore:           res.GunningFogScore(),
+		ColemanLiauIndex:          res.ColemanLiauIndex(),
+		SMOGIndex:                 res.SMOGIndex(),
+		AutomatedReadabilityIndex: res.AutomatedReadabilityIndex(),
+		DaleChallReadabilityScore: res.DaleChallReadabilityScore(),
+	}, res.AllScores())
+	s.Equal(19.653623188405795, res.AllScores().GunningFogScore)
+
+	empty, _ := Analyse(strings.NewReader(""))
+	s.Equal(ScoreSet{}, empty.AllScores())
+}
+
+func TestScores(t *testing.T) {
+	suite.Run(t, new(ScoresSuite))
+}
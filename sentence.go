@@ -0,0 +1,237 @@
+package textstats
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// abbreviations are common abbreviations, by language, that are followed by a
+// full stop without ending the sentence
+var abbreviations = map[string][]string{
+	"en": {
+		"mr", "mrs", "ms", "dr", "prof", "sr", "jr", "st", "mt", "rev", "hon",
+		"gen", "col", "lt", "sgt", "capt", "gov", "sen", "rep", "vs", "etc",
+		"e.g", "i.e", "cf", "al", "approx", "dept", "est", "fig", "inc", "ltd",
+		"co", "corp", "jan", "feb", "mar", "apr", "jun", "jul", "aug", "sep",
+		"sept", "oct", "nov", "dec", "vol", "pp", "a.m", "p.m",
+	},
+	"de": {
+		"hr", "fr", "dr", "prof", "z.b", "usw", "bzw", "d.h", "ca", "ggf",
+		"evtl", "nr", "str", "u.a", "vgl", "s.o", "s.u", "sog", "bspw",
+		"jan", "feb", "mär", "apr", "jun", "jul", "aug", "sep", "okt", "nov",
+		"dez",
+	},
+	"fr": {
+		"m", "mm", "mme", "mlle", "dr", "pr", "etc", "p.ex", "cf", "env",
+		"av", "bd", "st", "ste", "janv", "févr", "avr", "juil", "sept", "oct",
+		"nov", "déc",
+	},
+	"es": {
+		"sr", "sra", "srta", "dr", "dra", "ud", "uds", "etc", "p.ej", "pág",
+		"núm", "av", "ene", "feb", "mar", "abr", "jun", "jul", "ago", "sept",
+		"oct", "nov", "dic",
+	},
+}
+
+// abbreviationSets are the normalised abbreviations for each language
+var abbreviationSets = func() map[string]map[string]struct{} {
+	sets := make(map[string]map[string]struct{}, len(abbreviations))
+	for lang, list := range abbreviations {
+		sets[lang] = abbreviationSet(list)
+	}
+	return sets
+}()
+
+// DefaultAbbreviations returns the built in abbreviations for a language, such
+// as "en" or "de", or nil if there are none
+func DefaultAbbreviations(lang string) []string {
+	return append([]string(nil), abbreviations[lang]...)
+}
+
+// abbreviationSet normalises a list of abbreviations for lookup
+func abbreviationSet(list []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(list))
+	for _, abbr := range list {
+		set[strings.TrimRight(strings.ToLower(abbr), ".")] = struct{}{}
+	}
+	return set
+}
+
+// SentenceTerminators are the runes that end a sentence by default
+const SentenceTerminators = ".!?…"
+
+// closingPunctuation can follow a terminator without ending the sentence
+const closingPunctuation = "\"')]}’”»"
+
+// SentenceResult is the analysis of a single sentence. Its Results count only
+// the words in the sentence, so the readability scores are for the sentence
+// alone.
+type SentenceResult struct {
+	// Start and End are the byte offsets of the sentence in the text
+	Start, End int
+
+	// Text is the text of the sentence
+	Text string
+
+	Results
+}
+
+// HardestSentences returns the n sentences with the highest scores, hardest
+// first, where score is a grade level formula such as
+// (*Results).FleschKincaidGradeLevel. The analysis must have been run with
+// WithSentenceDetails.
+func (r *Results) HardestSentences(n int, score func(*Results) float64) []SentenceResult {
+	scores := make([]float64, len(r.SentenceDetails))
+	order := make([]int, len(r.SentenceDetails))
+	for i := range r.SentenceDetails {
+		scores[i] = score(&r.SentenceDetails[i].Results)
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	switch {
+	case n > len(order):
+		n = len(order)
+	case n < 0:
+		n = 0
+	}
+
+	hardest := make([]SentenceResult, 0, n)
+	for _, i := range order[:n] {
+		hardest = append(hardest, r.SentenceDetails[i])
+	}
+	return hardest
+}
+
+// segmenter decides where sentences end from a stream of tokens.
+//
+// A terminator only ends a sentence once the next word has been seen, so that
+// abbreviations, initialisms, decimals and ellipses can be told apart from a
+// real sentence end.
+type segmenter struct {
+	abbreviations map[string]struct{}
+	terminators   string
+
+	words    int  // words in the current sentence, counted by the analyser
+	pending  bool // a terminator has been seen
+	abbrev   bool // the terminator followed an abbreviation
+	ellipsis bool // the terminator was an ellipsis
+	closed   bool // the terminator was followed by a closing quote or bracket
+	spaced   bool // whitespace has been seen since the terminator
+
+	// chunk is the text seen since the last whitespace, and lower is reused
+	// for it in lower case
+	chunk, lower []byte
+}
+
+// token processes the next token and reports whether a sentence ended before
+// it
+func (s *segmenter) token(kind TokenKind, text []byte) (ended bool) {
+	switch kind {
+	case SpaceToken:
+		s.chunk = s.chunk[:0]
+		if s.pending {
+			s.spaced = true
+		}
+		return false
+	case PunctToken:
+		s.punct(text)
+		s.chunk = append(s.chunk, text...)
+		return false
+	}
+
+	if s.pending {
+		if !s.spaced {
+			// decimals, initialisms and domain names
+			s.pending = false
+		} else {
+			ended = s.end(text)
+		}
+	}
+
+	s.chunk = append(s.chunk, text...)
+
+	return ended
+}
+
+// punct handles punctuation tokens
+func (s *segmenter) punct(text []byte) {
+	r, _ := utf8.DecodeRune(text)
+	switch {
+	case strings.ContainsRune(s.terminators, r):
+		if s.pending && !s.spaced {
+			s.ellipsis = s.ellipsis || r == '.' || r == '…'
+			return
+		}
+
+		s.pending = true
+		s.spaced = false
+		s.closed = false
+		s.ellipsis = r == '…'
+		s.abbrev = r == '.' && s.isAbbreviation()
+	case s.pending && !s.spaced:
+		if strings.ContainsRune(closingPunctuation, r) {
+			s.closed = true
+		} else {
+			// anything else straight after a terminator, such as the comma in
+			// "e.g.," means the sentence carries on
+			s.pending = false
+		}
+	}
+}
+
+// end decides if the pending terminator ended the sentence, given the text of
+// the token that follows it
+func (s *segmenter) end(next []byte) bool {
+	s.pending = false
+
+	if s.abbrev {
+		return false
+	}
+
+	if s.ellipsis || s.closed {
+		if r, _ := utf8.DecodeRune(next); unicode.IsLower(r) {
+			return false
+		}
+	}
+
+	return s.flush()
+}
+
+// isAbbreviation reports whether the chunk before a full stop is a known
+// abbreviation or an initialism such as "U.S.A"
+func (s *segmenter) isAbbreviation() bool {
+	s.lower = appendLower(s.lower[:0], bytes.TrimLeftFunc(s.chunk, unicode.IsPunct))
+	if len(s.lower) == 0 {
+		return false
+	}
+
+	if _, ok := s.abbreviations[string(s.lower)]; ok {
+		return true
+	}
+
+	if i := bytes.LastIndexByte(s.lower, '.'); i >= 0 {
+		return utf8.RuneCount(s.lower[i+1:]) == 1
+	}
+
+	return false
+}
+
+// flush ends the current sentence, reporting whether it had any words
+func (s *segmenter) flush() bool {
+	ended := s.words > 0
+	s.words = 0
+	return ended
+}
+
+// finish reports whether the text ended with a sentence still open
+func (s *segmenter) finish() bool {
+	s.pending = false
+	return s.flush()
+}
@@ -0,0 +1,138 @@
+package textstats
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SentenceSuite struct {
+	suite.Suite
+}
+
+func (s *SentenceSuite) TestSentenceCount() {
+	texts := map[string]int{
+		"":                                     0,
+		"No terminator":                        1,
+		"One. Two! Three? Four":                4,
+		"Really?! Yes.":                        2,
+		"Ask Dr. Smith about it.":              1,
+		"Fruit, e.g. apples, is good for you.": 1,
+		"Fruit, e.g., apples, is good.":        1,
+		"He moved to the U.S.A. last year.":    1,
+		"Pi is roughly 3.14 or so.":            1,
+		"Visit example.com today.":             1,
+		"Wait... what was that?":               1,
+		"Wait... What was that?":               2,
+		"Wait… what was that?":                 1,
+		`"Why?" she asked. "Because."`:         2,
+		`He said "Stop." Then he left.`:        2,
+		"(It ended.) Then another began.":      2,
+		"It was 5 p.m. when we left. We ran.":  2,
+		"Items: apples, pears, etc. and more.": 1,
+		"!!! ... ???":                          0,
+		"First line.\nSecond line.\n\nThird.":  3,
+		"Mr. and Mrs. Jones went to St. Ives.": 1,
+	}
+
+	for text, count := range texts {
+		s.Equal(count, SentenceCount(text), fmt.Sprintf("%q should have %d sentences", text, count))
+	}
+}
+
+func (s *SentenceSuite) TestLanguage() {
+	text := "Das ist z.B. ein Satz. Und bzw. noch einer."
+
+	res, _ := Analyse(strings.NewReader(text), WithLanguage("de"))
+	s.Equal(2, res.Sentences)
+
+	res, _ = Analyse(strings.NewReader(text))
+	s.Equal(3, res.Sentences)
+}
+
+func (s *SentenceSuite) TestAbbreviations() {
+	text := "See Fig. 3 and Sec. Four for details."
+
+	res, _ := Analyse(strings.NewReader(text))
+	s.Equal(2, res.Sentences)
+
+	res, _ = Analyse(strings.NewReader(text), WithAbbreviations(append(DefaultAbbreviations("en"), "Sec.")...))
+	s.Equal(1, res.Sentences)
+
+	res, _ = Analyse(strings.NewReader("Ask Dr. Smith."), WithAbbreviations())
+	s.Equal(2, res.Sentences)
+}
+
+func (s *SentenceSuite) TestSentenceDetails() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	s.Nil(res.SentenceDetails)
+
+	text := "  Go now. \"Wait,\" she said (quietly)!  The end"
+	res, _ = Analyse(strings.NewReader(text), WithSentenceDetails(), WithWordDetails())
+	s.Require().Len(res.SentenceDetails, 3)
+	s.Equal(3, res.Sentences)
+
+	var words, syllables, letters int
+	for _, sentence := range res.SentenceDetails {
+		s.Equal(sentence.Text, text[sentence.Start:sentence.End])
+		s.Equal(1, sentence.Sentences)
+		s.Len(sentence.WordDetails, sentence.Words)
+		words += sentence.Words
+		syllables += sentence.Syllables
+		letters += sentence.Letters
+	}
+
+	s.Equal("Go now.", res.SentenceDetails[0].Text)
+	s.Equal(`"Wait," she said (quietly)!`, res.SentenceDetails[1].Text)
+	s.Equal("The end", res.SentenceDetails[2].Text)
+	s.Equal(4, res.SentenceDetails[1].Words)
+	s.Equal(res.Words, words)
+	s.Equal(res.Syllables, syllables)
+	s.Equal(res.Letters, letters)
+	s.Len(res.WordDetails, res.Words)
+
+	plain, _ := Analyse(strings.NewReader(text))
+	s.Equal(plain.Words, res.Words)
+	s.Equal(plain.Punctuation, res.Punctuation)
+	s.Equal(plain.Spaces, res.Spaces)
+	s.Equal(plain.FleschKincaidGradeLevel(), res.FleschKincaidGradeLevel())
+}
+
+func (s *SentenceSuite) TestTrailingTokens() {
+	for _, text := range []string{
+		"Hi. 12\n\nBye.",
+		"Hi. ©\n\nBye.",
+		"Hi. \u200b\n\nBye.",
+		"Hi. \ufeff\n\nBye.",
+		"Hi.\n\u00ad\n\nBye.",
+	} {
+		res, err := Analyse(strings.NewReader(text), WithSentenceDetails(), WithParagraphDetails())
+		s.NoError(err, "%q", text)
+		s.Require().Len(res.SentenceDetails, 2, "%q", text)
+		s.Equal("Hi.", res.SentenceDetails[0].Text, "%q", text)
+		s.Equal("Bye.", res.SentenceDetails[1].Text, "%q", text)
+		for _, sentence := range res.SentenceDetails {
+			s.Equal(sentence.Text, text[sentence.Start:sentence.End], "%q", text)
+		}
+	}
+}
+
+func (s *SentenceSuite) TestHardestSentences() {
+	text := "The cat sat. Unquestionably, comprehensive documentation facilitates understanding. The dog ran far away."
+	res, _ := Analyse(strings.NewReader(text), WithSentenceDetails())
+
+	hardest := res.HardestSentences(2, (*Results).FleschKincaidGradeLevel)
+	s.Require().Len(hardest, 2)
+	s.Equal("Unquestionably, comprehensive documentation facilitates understanding.", hardest[0].Text)
+	s.Equal("The dog ran far away.", hardest[1].Text)
+	s.True(hardest[0].FleschKincaidGradeLevel() > hardest[1].FleschKincaidGradeLevel())
+
+	s.Len(res.HardestSentences(10, (*Results).SMOGIndex), 3)
+	s.Empty(res.HardestSentences(-1, (*Results).SMOGIndex))
+}
+
+func TestSentences(t *testing.T) {
+	suite.Run(t, new(SentenceSuite))
+}
@@ -0,0 +1,217 @@
+package textstats
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	smallNumbers = [...]string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+		"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+		"sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tens = [...]string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+		"eighty", "ninety",
+	}
+	scales = [...]string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion",
+		"quintillion",
+	}
+	irregularOrdinals = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// SpellNumber is a TokenRule that counts a number as the English words it
+// would be read aloud as, such as "nineteen ninety-nine" for "1999" or "three
+// point one four" for "3.14". Four digit numbers that look like years are
+// read as years.
+func SpellNumber(text string) []string {
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "v"), "V")
+
+	// Split off any suffix such as "%", "th" or "kg"
+	end := strings.LastIndexFunc(text, isDigit) + 1
+	number, suffix := text[:end], text[end:]
+
+	// Commas between groups of three digits are thousands separators
+	if isGrouped(number) {
+		number = strings.Replace(number, ",", "", -1)
+	}
+
+	parts := strings.FieldsFunc(number, func(r rune) bool { return !isDigit(r) })
+	if len(parts) == 0 {
+		return nil
+	}
+
+	separators := []rune(strings.Map(func(r rune) rune {
+		if isDigit(r) {
+			return -1
+		}
+		return r
+	}, number))
+
+	words := spellDigits(parts[0], len(parts) == 1)
+	for i, digits := range parts[1:] {
+		switch separators[i] {
+		case '.':
+			words = append(words, "point")
+			if len(parts) == 2 {
+				// decimals are read one digit at a time
+				for _, d := range digits {
+					words = append(words, smallNumbers[d-'0'])
+				}
+				continue
+			}
+		case '/':
+			words = append(words, "over")
+		}
+		words = append(words, spellDigits(digits, false)...)
+	}
+
+	switch strings.ToLower(suffix) {
+	case "":
+	case "%":
+		words = append(words, "percent")
+	case "st", "nd", "rd", "th":
+		words[len(words)-1] = ordinal(words[len(words)-1])
+	case "s":
+		words[len(words)-1] = plural(words[len(words)-1])
+	default:
+		words = append(words, suffix)
+	}
+
+	return words
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// spellDigits returns the words for a run of digits
+func spellDigits(digits string, year bool) []string {
+	switch {
+	case year && isYear(digits):
+		n, _ := strconv.Atoi(digits)
+		return spellYear(n)
+	case len(digits) > 1 && digits[0] == '0', len(digits) > 18:
+		words := make([]string, 0, len(digits))
+		for _, d := range digits {
+			words = append(words, smallNumbers[d-'0'])
+		}
+		return words
+	}
+
+	n, _ := strconv.ParseUint(digits, 10, 64)
+	return spellCardinal(n)
+}
+
+// isGrouped reports whether commas in a number separate groups of thousands
+func isGrouped(number string) bool {
+	groups := strings.Split(number, ",")
+	if len(groups) < 2 || len(groups[0]) > 3 {
+		return false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 || strings.ContainsAny(group, ".:/") {
+			return false
+		}
+	}
+	return true
+}
+
+// isYear reports whether a number is likely to be read as a year
+func isYear(digits string) bool {
+	if len(digits) != 4 || digits[0] == '0' {
+		return false
+	}
+	n, _ := strconv.Atoi(digits)
+	return (n >= 1100 && n < 2000 && n%100 != 0) || (n >= 2010 && n < 2100)
+}
+
+// spellYear returns the words for a year, read as two pairs of digits
+func spellYear(n int) []string {
+	words := spellCardinal(uint64(n / 100))
+	if n%100 < 10 {
+		return append(words, "oh", smallNumbers[n%10])
+	}
+	return append(words, spellCardinal(uint64(n%100))...)
+}
+
+// spellCardinal returns the words for a whole number
+func spellCardinal(n uint64) []string {
+	if n == 0 {
+		return []string{"zero"}
+	}
+
+	var groups [][]string
+	for scale := 0; n > 0; scale++ {
+		if group := n % 1000; group > 0 {
+			words := spellHundreds(int(group))
+			if scales[scale] != "" {
+				words = append(words, scales[scale])
+			}
+			groups = append(groups, words)
+		}
+		n /= 1000
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		words = append(words, groups[i]...)
+	}
+	return words
+}
+
+// spellHundreds returns the words for a number below one thousand
+func spellHundreds(n int) []string {
+	var words []string
+	if n >= 100 {
+		words = append(words, smallNumbers[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, smallNumbers[n])
+	case n%10 == 0:
+		words = append(words, tens[n/10])
+	default:
+		words = append(words, tens[n/10]+"-"+smallNumbers[n%10])
+	}
+
+	return words
+}
+
+// ordinal returns the ordinal form of a spelled number, such as "twenty-first"
+// for "twenty-one"
+func ordinal(word string) string {
+	i := strings.LastIndexByte(word, '-') + 1
+	prefix, last := word[:i], word[i:]
+	switch {
+	case irregularOrdinals[last] != "":
+		return prefix + irregularOrdinals[last]
+	case strings.HasSuffix(last, "y"):
+		return prefix + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return word + "th"
+}
+
+// plural returns the plural form of a spelled number, such as "nineties"
+func plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "y"):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case strings.HasSuffix(word, "x"):
+		return word + "es"
+	}
+	return word + "s"
+}
@@ -0,0 +1,45 @@
+package textstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SpellSuite struct {
+	suite.Suite
+}
+
+func (s *SpellSuite) TestSpellNumber() {
+	numbers := map[string][]string{
+		"0":         {"zero"},
+		"7":         {"seven"},
+		"42":        {"forty-two"},
+		"101":       {"one", "hundred", "one"},
+		"1999":      {"nineteen", "ninety-nine"},
+		"1905":      {"nineteen", "oh", "five"},
+		"2000":      {"two", "thousand"},
+		"2024":      {"twenty", "twenty-four"},
+		"1,000,000": {"one", "million"},
+		"3.14":      {"three", "point", "one", "four"},
+		"v1.2.3":    {"one", "point", "two", "point", "three"},
+		"10:30":     {"ten", "thirty"},
+		"1/2":       {"one", "over", "two"},
+		"007":       {"zero", "zero", "seven"},
+		"5%":        {"five", "percent"},
+		"12th":      {"twelfth"},
+		"21st":      {"twenty-first"},
+		"30th":      {"thirtieth"},
+		"1990s":     {"nineteen", "nineties"},
+		"5kg":       {"five", "kg"},
+		"none":      nil,
+	}
+
+	for number, words := range numbers {
+		s.Equal(words, SpellNumber(number), number)
+	}
+}
+
+func TestSpell(t *testing.T) {
+	suite.Run(t, new(SpellSuite))
+}
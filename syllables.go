@@ -0,0 +1,175 @@
+package textstats
+
+import (
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// SyllableCounter counts the syllables in a word. The word is made only of
+// letters, but may be in any case.
+type SyllableCounter interface {
+	Syllables(word string) int
+}
+
+// SyllableCounterFunc adapts a function to a SyllableCounter
+type SyllableCounterFunc func(word string) int
+
+// Syllables implements SyllableCounter
+func (f SyllableCounterFunc) Syllables(word string) int {
+	return f(word)
+}
+
+// SyllableRules are the heuristics used to count the syllables in a word. It
+// is the default SyllableCounter.
+type SyllableRules struct {
+	// ProblemWords are words that don't follow the rules, with their syllable
+	// counts
+	ProblemWords map[string]int
+
+	// SubSyllables are syllables that would be counted as two but should be one
+	SubSyllables []*regexp.Regexp
+
+	// AddSyllables are syllables that would be counted as one but should be two
+	AddSyllables []*regexp.Regexp
+
+	// PrefixSuffixes are single syllable prefixes and suffixes
+	PrefixSuffixes []*regexp.Regexp
+
+	// compiled holds the *ruleMatchers the rules were last compiled to
+	compiled atomic.Value
+}
+
+// DefaultSyllableRules returns the built in syllable counting rules. The
+// returned rules can be changed without affecting any other analysis.
+func DefaultSyllableRules() *SyllableRules {
+	return &SyllableRules{
+		ProblemWords:   copyCounts(problemWords),
+		SubSyllables:   append([]*regexp.Regexp(nil), subSyllables[:]...),
+		AddSyllables:   append([]*regexp.Regexp(nil), addSyllables[:]...),
+		PrefixSuffixes: append([]*regexp.Regexp(nil), prefixSuffixes[:]...),
+	}
+}
+
+// defaultSyllableRules are the rules used unless changed with
+// WithSyllableRules. They are never changed.
+var defaultSyllableRules = DefaultSyllableRules()
+
+// builtinMatchers are the built in rules compiled to matchers, shared by any
+// SyllableRules that still use them
+var builtinMatchers = func() map[*regexp.Regexp]*matcher {
+	m := make(map[*regexp.Regexp]*matcher)
+	for _, list := range [][]*regexp.Regexp{subSyllables[:], addSyllables[:], prefixSuffixes[:]} {
+		for _, re := range list {
+			m[re] = newMatcher(re)
+		}
+	}
+	return m
+}()
+
+// ruleMatchers are the rules of a SyllableRules compiled to matchers
+type ruleMatchers struct {
+	sub, add, prefixSuffix []*matcher
+}
+
+// matchers returns the rules compiled to matchers, compiling them again if
+// they have changed since they were last used
+func (s *SyllableRules) matchers() *ruleMatchers {
+	if m, ok := s.compiled.Load().(*ruleMatchers); ok && m.compiledFrom(s) {
+		return m
+	}
+
+	m := &ruleMatchers{
+		sub:          compileRules(s.SubSyllables),
+		add:          compileRules(s.AddSyllables),
+		prefixSuffix: compileRules(s.PrefixSuffixes),
+	}
+	s.compiled.Store(m)
+	return m
+}
+
+// compiledFrom reports whether the matchers were compiled from the current
+// rules
+func (m *ruleMatchers) compiledFrom(s *SyllableRules) bool {
+	return sameRules(m.sub, s.SubSyllables) &&
+		sameRules(m.add, s.AddSyllables) &&
+		sameRules(m.prefixSuffix, s.PrefixSuffixes)
+}
+
+func sameRules(matchers []*matcher, rules []*regexp.Regexp) bool {
+	if len(matchers) != len(rules) {
+		return false
+	}
+	for i, m := range matchers {
+		if m.regex != rules[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func compileRules(rules []*regexp.Regexp) []*matcher {
+	matchers := make([]*matcher, len(rules))
+	for i, re := range rules {
+		if matchers[i] = builtinMatchers[re]; matchers[i] == nil {
+			matchers[i] = newMatcher(re)
+		}
+	}
+	return matchers
+}
+
+// Syllables implements SyllableCounter
+func (s *SyllableRules) Syllables(word string) int {
+	word = strings.ToLower(word)
+
+	// return early if we have a problem word
+	if sCount, ok := s.ProblemWords[word]; ok {
+		return sCount
+	}
+
+	var buf [32]rune
+	runes := buf[:0]
+	for _, r := range word {
+		runes = append(runes, r)
+	}
+	return s.matchers().heuristic(runes)
+}
+
+// heuristic returns the number of syllables in a lower case word, ignoring
+// any problem words. The word's runes are overwritten.
+func (m *ruleMatchers) heuristic(word []rune) (sCount int) {
+	var prefixSuffixCount int
+	for _, rule := range m.prefixSuffix {
+		if rule.match(word) {
+			word = rule.remove(word)
+			prefixSuffixCount++
+		}
+	}
+
+	// Count the groups of vowels between the consonants
+	var wordPartCount int
+	vowel := false
+	for _, r := range word {
+		isVowel := r == 'a' || r == 'e' || r == 'i' || r == 'o' || r == 'u' || r == 'y'
+		if isVowel && !vowel {
+			wordPartCount++
+		}
+		vowel = isVowel
+	}
+
+	sCount = wordPartCount + prefixSuffixCount
+
+	for _, rule := range m.sub {
+		if rule.match(word) {
+			sCount--
+		}
+	}
+
+	for _, rule := range m.add {
+		if rule.match(word) {
+			sCount++
+		}
+	}
+
+	return
+}
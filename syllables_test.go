@@ -0,0 +1,53 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SyllablesSuite struct {
+	suite.Suite
+}
+
+func (s *SyllablesSuite) TestSyllableRules() {
+	var counter SyllableCounter = DefaultSyllableRules()
+	s.Equal(4, counter.Syllables("Advertisement"))
+	s.Equal(3, counter.Syllables("forever"))
+	s.Equal(1, counter.Syllables("why"))
+}
+
+func (s *SyllablesSuite) TestWithSyllableCounter() {
+	vowels := SyllableCounterFunc(func(word string) int {
+		return strings.IndexAny(word, "aeiou") + 1
+	})
+
+	res, _ := Analyse(strings.NewReader("Hello there, world"), WithSyllableCounter(vowels))
+	s.Equal(3, res.Words)
+	s.Equal(2+3+2, res.Syllables)
+	s.Equal(1, res.WordsWithAtLeastNSyllables(3, true))
+}
+
+func (s *SyllablesSuite) TestDictionaryOverridesCounter() {
+	d := NewDictionary()
+	d.AddProblemWord("hello", 5)
+
+	one := SyllableCounterFunc(func(string) int { return 1 })
+	res, _ := Analyse(strings.NewReader("hello world"), WithSyllableCounter(one), WithDictionary(d))
+	s.Equal(6, res.Syllables)
+}
+
+func TestSyllables(t *testing.T) {
+	suite.Run(t, new(SyllablesSuite))
+}
+
+func BenchmarkSyllables(b *testing.B) {
+	words := strings.Fields(strings.NewReplacer(",", "", ".", "").Replace(lorem))
+	rules := DefaultSyllableRules()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rules.Syllables(words[i%len(words)])
+	}
+}
@@ -32,9 +32,9 @@ func (s *StringSuite) TestWordsWithAtLeastNSyllables() {
 	s.Equal(1, WordsWithAtLeastNSyllables(hw, 4, true))
 	s.Equal(0, WordsWithAtLeastNSyllables(hw, 5, true))
 
-	s.Equal(4, WordsWithAtLeastNSyllables(hw, 0, false))
-	s.Equal(4, WordsWithAtLeastNSyllables(hw, 1, false))
-	s.Equal(2, WordsWithAtLeastNSyllables(hw, 2, false))
+	s.Equal(5, WordsWithAtLeastNSyllables(hw, 0, false))
+	s.Equal(5, WordsWithAtLeastNSyllables(hw, 1, false))
+	s.Equal(3, WordsWithAtLeastNSyllables(hw, 2, false))
 	s.Equal(2, WordsWithAtLeastNSyllables(hw, 3, false))
 	s.Equal(1, WordsWithAtLeastNSyllables(hw, 4, false))
 	s.Equal(0, WordsWithAtLeastNSyllables(hw, 5, false))
@@ -48,10 +48,10 @@ func (s *StringSuite) TestPercentageWordsWithAtLeastNSyllables() {
 	s.Equal(16.666666666666664, PercentageWordsWithAtLeastNSyllables(hw, 4, true))
 	s.Equal(0.0, PercentageWordsWithAtLeastNSyllables(hw, 5, true))
 
-	s.Equal(66.66666666666666, PercentageWordsWithAtLeastNSyllables(hw, 0, false))
-	s.Equal(66.66666666666666, PercentageWordsWithAtLeastNSyllables(hw, 1, false))
+	s.Equal(83.33333333333334, PercentageWordsWithAtLeastNSyllables(hw, 0, false))
+	s.Equal(83.33333333333334, PercentageWordsWithAtLeastNSyllables(hw, 1, false))
 	s.Equal(33.33333333333333, PercentageWordsWithAtLeastNSyllables(hw, 3, false))
-	s.Equal(33.33333333333333, PercentageWordsWithAtLeastNSyllables(hw, 2, false))
+	s.Equal(50.0, PercentageWordsWithAtLeastNSyllables(hw, 2, false))
 	s.Equal(16.666666666666664, PercentageWordsWithAtLeastNSyllables(hw, 4, false))
 	s.Equal(0.0, PercentageWordsWithAtLeastNSyllables(hw, 5, false))
 }
@@ -116,7 +116,7 @@ func (s *StringSuite) TestFleschKincaidGradeLevel() {
 }
 
 func (s *StringSuite) TestGunningFogScore() {
-	s.Equal(19.073913043478264, GunningFogScore(lorem))
+	s.Equal(19.653623188405795, GunningFogScore(lorem))
 }
 
 func (s *StringSuite) TestColemanLiauIndex() {
@@ -135,6 +135,13 @@ func (s *StringSuite) TestDaleChallReadabilityScore() {
 	s.Equal(5.837344444444444, DaleChallReadabilityScore(qbf))
 }
 
+func (s *StringSuite) TestScores() {
+	scores := Scores(lorem)
+	s.Equal(GunningFogScore(lorem), scores.GunningFogScore)
+	s.Equal(SMOGIndex(lorem), scores.SMOGIndex)
+	s.Equal(DaleChallReadabilityScore(lorem), scores.DaleChallReadabilityScore)
+}
+
 func TestStringMethods(t *testing.T) {
 	suite.Run(t, new(StringSuite))
 }
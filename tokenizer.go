@@ -0,0 +1,353 @@
+package textstats
+
+import (
+	"bufio"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind identifies what sort of text a token contains
+type TokenKind int
+
+const (
+	// OtherToken is text that is neither a word, space nor punctuation and is
+	// ignored by the analysis
+	OtherToken TokenKind = iota
+	// WordToken is a single word. Only the letters within it are counted.
+	WordToken
+	// SpaceToken is a run of whitespace
+	SpaceToken
+	// PunctToken is punctuation
+	PunctToken
+	// NumberToken is a number, such as "2024", "3.14" or "v1.2.3"
+	NumberToken
+	// URLToken is a web address, such as "https://example.com"
+	URLToken
+	// EmailToken is an email address
+	EmailToken
+	// MentionToken is a mention of a user, such as "@gopher"
+	MentionToken
+	// HashtagToken is a hashtag, such as "#golang"
+	HashtagToken
+)
+
+// Tokenizer splits text into tokens for analysis.
+//
+// Tokenize is given the unprocessed input and returns the length in bytes and
+// kind of the token at the start of data. Every byte of the input belongs to
+// exactly one token. As with a bufio.SplitFunc, returning a zero length asks
+// for more data, unless atEOF is true in which case data is empty. A token
+// that still needs more data after bufio.MaxScanTokenSize bytes is ended
+// there, as if the text ended, so long runs of letters or whitespace are
+// split into several tokens rather than stopping the analysis.
+type Tokenizer interface {
+	Tokenize(data []byte, atEOF bool) (length int, kind TokenKind, err error)
+}
+
+// maxTokenSize is the longest token the analysis buffers. Longer runs of
+// letters or whitespace are split into tokens of about this size, as if the
+// text ended there.
+const maxTokenSize = bufio.MaxScanTokenSize
+
+// nextToken returns the length and kind of the token at the start of data,
+// splitting tokens longer than maxTokenSize. Only the first maxTokenSize
+// bytes are passed to t, without any rune they cut through, so where a long
+// token is split doesn't depend on how much text follows it.
+func nextToken(t Tokenizer, data []byte, atEOF bool) (int, TokenKind, error) {
+	if len(data) < maxTokenSize {
+		return t.Tokenize(data, atEOF)
+	}
+
+	window := data[:maxTokenSize]
+	for i := len(window) - 1; i >= len(window)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(window[i]) {
+			if !utf8.FullRune(window[i:]) {
+				window = window[:i]
+			}
+			break
+		}
+	}
+
+	n, kind, err := t.Tokenize(window, false)
+	if n == 0 && err == nil {
+		n, kind, err = t.Tokenize(window, true)
+	}
+	return n, kind, err
+}
+
+// Apostrophes are the runes treated as apostrophes within contractions and
+// possessives such as "you'll" and "dog's"
+const Apostrophes = "'\u2019"
+
+// Hyphens are the runes treated as hyphens within compounds such as
+// "well-known"
+const Hyphens = "-\u2010\u2011"
+
+// softHyphen marks where a word may be broken across lines, and never splits
+// the word it is in
+const softHyphen = '\u00ad'
+
+// DefaultTokenizer is the Tokenizer used when none is given to Analyse. It
+// keeps contractions, possessives and hyphenated compounds together as single
+// words, and recognises numbers, URLs, email addresses, mentions and hashtags.
+var DefaultTokenizer Tokenizer = SimpleTokenizer{
+	Joiners:  Apostrophes + Hyphens,
+	Entities: true,
+}
+
+// SimpleTokenizer builds words from runs of letters. Any space or punctuation
+// rune ends a word, while other runes such as digits are skipped over without
+// breaking the word they appear in.
+type SimpleTokenizer struct {
+	// Joiners are punctuation runes that join the letters either side of them
+	// into a single word, such as the apostrophe in "don't". Hyphens that are
+	// joiners also rejoin words hyphenated across the end of a line.
+	Joiners string
+
+	// Entities enables NumberToken, URLToken, EmailToken, MentionToken and
+	// HashtagToken tokens
+	Entities bool
+}
+
+// Tokenize implements Tokenizer
+func (t SimpleTokenizer) Tokenize(data []byte, atEOF bool) (int, TokenKind, error) {
+	if t.Entities && len(data) > 0 {
+		n, kind, more := entity(data, atEOF)
+		if more {
+			return 0, kind, nil
+		}
+		if n > 0 {
+			return n, kind, nil
+		}
+	}
+
+	return tokenizeRunes(data, atEOF, simpleClass, t.Joiners)
+}
+
+func simpleClass(r rune) TokenKind {
+	switch {
+	case unicode.IsLetter(r):
+		return WordToken
+	case unicode.IsSpace(r):
+		return SpaceToken
+	case unicode.IsPunct(r):
+		return PunctToken
+	}
+	return OtherToken
+}
+
+// RuleTokenizer is a Tokenizer with configurable word boundary rules, for text
+// where words are made of more than plain letters.
+type RuleTokenizer struct {
+	// IsWordRune reports whether r is part of a word. If nil, unicode.IsLetter
+	// is used.
+	IsWordRune func(r rune) bool
+
+	// Joiners are runes that join the word runes either side of them into a
+	// single word, such as the apostrophe in "don't". Anywhere else they are
+	// treated as punctuation. Hyphens that are joiners also rejoin words
+	// hyphenated across the end of a line. Soft hyphens always join.
+	Joiners string
+
+	// Breaks are runes that always end a word and are treated as punctuation,
+	// even if IsWordRune would accept them.
+	Breaks string
+
+	// Entities enables NumberToken, URLToken, EmailToken, MentionToken and
+	// HashtagToken tokens
+	Entities bool
+}
+
+// Tokenize implements Tokenizer
+func (t *RuleTokenizer) Tokenize(data []byte, atEOF bool) (int, TokenKind, error) {
+	if len(data) == 0 || (!atEOF && !utf8.FullRune(data)) {
+		return 0, OtherToken, nil
+	}
+
+	if t.Entities {
+		n, kind, more := entity(data, atEOF)
+		if more {
+			return 0, kind, nil
+		}
+		if n > 0 {
+			return n, kind, nil
+		}
+	}
+
+	r, size := utf8.DecodeRune(data)
+	switch kind := t.class(r); kind {
+	case WordToken:
+	case PunctToken:
+		return size, kind, nil
+	default:
+		return runOf(data, atEOF, func(r rune) bool { return t.class(r) == kind }, kind)
+	}
+
+	i := size
+	for i < len(data) {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			return 0, WordToken, nil
+		}
+
+		r, size = utf8.DecodeRune(data[i:])
+		if t.isWordRune(r) {
+			i += size
+			continue
+		}
+
+		if r != softHyphen && !strings.ContainsRune(t.Joiners, r) {
+			return i, WordToken, nil
+		}
+
+		n, more := joinLength(data[i:], atEOF, t.isWordRune)
+		if more {
+			return 0, WordToken, nil
+		}
+		if n == 0 {
+			return i, WordToken, nil
+		}
+		i += n
+	}
+
+	if !atEOF {
+		return 0, WordToken, nil
+	}
+
+	return i, WordToken, nil
+}
+
+func (t *RuleTokenizer) isWordRune(r rune) bool {
+	if strings.ContainsRune(t.Breaks, r) {
+		return false
+	}
+	if t.IsWordRune == nil {
+		return unicode.IsLetter(r)
+	}
+	return t.IsWordRune(r)
+}
+
+func (t *RuleTokenizer) class(r rune) TokenKind {
+	switch {
+	case t.isWordRune(r):
+		return WordToken
+	case unicode.IsSpace(r):
+		return SpaceToken
+	case unicode.IsPunct(r), strings.ContainsRune(t.Joiners, r), strings.ContainsRune(t.Breaks, r):
+		return PunctToken
+	}
+	return OtherToken
+}
+
+// tokenizeRunes returns the token at the start of data, where class gives the
+// kind of each rune. Words continue through OtherToken runes and any joiners
+// between letters, runs of whitespace form a single token and each
+// punctuation rune is its own token.
+func tokenizeRunes(data []byte, atEOF bool, class func(rune) TokenKind, joiners string) (int, TokenKind, error) {
+	if len(data) == 0 || (!atEOF && !utf8.FullRune(data)) {
+		return 0, OtherToken, nil
+	}
+
+	r, size := utf8.DecodeRune(data)
+	kind := class(r)
+	if kind == PunctToken {
+		return size, kind, nil
+	}
+
+	i := size
+	for i < len(data) {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			return 0, kind, nil
+		}
+
+		r, size = utf8.DecodeRune(data[i:])
+		next := class(r)
+		switch {
+		case next == kind:
+		case kind == WordToken && next == OtherToken:
+			// skipped, but doesn't break the word
+		case kind == OtherToken && next == WordToken:
+			kind = WordToken
+		case kind == WordToken && strings.ContainsRune(joiners, r):
+			n, more := joinLength(data[i:], atEOF, func(r rune) bool {
+				return class(r) == WordToken
+			})
+			if more {
+				return 0, kind, nil
+			}
+			if n == 0 {
+				return i, kind, nil
+			}
+			size = n
+		default:
+			return i, kind, nil
+		}
+		i += size
+	}
+
+	if !atEOF {
+		return 0, kind, nil
+	}
+
+	return i, kind, nil
+}
+
+// runOf returns a token of the given kind made from the run of runes at the
+// start of data that match pred.
+func runOf(data []byte, atEOF bool, pred func(rune) bool, kind TokenKind) (int, TokenKind, error) {
+	i := 0
+	for i < len(data) {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			return 0, kind, nil
+		}
+
+		r, size := utf8.DecodeRune(data[i:])
+		if !pred(r) {
+			return i, kind, nil
+		}
+		i += size
+	}
+
+	if !atEOF {
+		return 0, kind, nil
+	}
+
+	return i, kind, nil
+}
+
+// joinLength is given data starting with a joiner and returns the length of
+// the joiner and the word rune following it, or 0 if it doesn't join two
+// words. A hyphen at the end of a line joins the lower case word that starts
+// the next line. more is true if there isn't enough data to decide.
+func joinLength(data []byte, atEOF bool, isWordRune func(rune) bool) (n int, more bool) {
+	r, size := utf8.DecodeRune(data)
+	i := size
+
+	var newlines int
+	if strings.ContainsRune(Hyphens, r) {
+		for i < len(data) && utf8.FullRune(data[i:]) {
+			sr, ssize := utf8.DecodeRune(data[i:])
+			if !unicode.IsSpace(sr) {
+				break
+			}
+			if sr == '\n' {
+				newlines++
+			}
+			i += ssize
+		}
+	}
+
+	if !atEOF && !utf8.FullRune(data[i:]) {
+		return 0, true
+	}
+
+	nr, nsize := utf8.DecodeRune(data[i:])
+	switch {
+	case i == len(data), !isWordRune(nr):
+		return 0, false
+	case i > size && (newlines != 1 || !unicode.IsLower(nr)):
+		return 0, false
+	}
+
+	return i + nsize, false
+}
@@ -0,0 +1,129 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenizerSuite struct {
+	suite.Suite
+}
+
+type token struct {
+	Kind TokenKind
+	Text string
+}
+
+func tokenize(t Tokenizer, text string) []token {
+	var tokens []token
+	data := []byte(text)
+	for len(data) > 0 {
+		n, kind, err := t.Tokenize(data, true)
+		if err != nil || n == 0 {
+			break
+		}
+		tokens = append(tokens, token{kind, string(data[:n])})
+		data = data[n:]
+	}
+	return tokens
+}
+
+func (s *TokenizerSuite) TestSimpleTokenizer() {
+	s.Equal([]token{
+		{WordToken, "Don"},
+		{PunctToken, "'"},
+		{WordToken, "t"},
+		{SpaceToken, "  "},
+		{WordToken, "ab12cd"},
+		{PunctToken, "."},
+		{PunctToken, "."},
+		{SpaceToken, "\n"},
+		{OtherToken, "42"},
+	}, tokenize(SimpleTokenizer{}, "Don't  ab12cd..\n42"))
+}
+
+func (s *TokenizerSuite) TestSimpleTokenizerJoiners() {
+	s.Equal([]token{
+		{WordToken, "you’ll"},
+		{SpaceToken, " "},
+		{WordToken, "dog's"},
+		{SpaceToken, " "},
+		{WordToken, "dogs"},
+		{PunctToken, "'"},
+		{SpaceToken, " "},
+		{PunctToken, "'"},
+		{WordToken, "quoted"},
+		{PunctToken, "'"},
+	}, tokenize(DefaultTokenizer, "you’ll dog's dogs' 'quoted'"))
+}
+
+func (s *TokenizerSuite) TestSimpleTokenizerNeedsMoreData() {
+	n, _, _ := SimpleTokenizer{}.Tokenize([]byte("hello"), false)
+	s.Equal(0, n)
+
+	n, kind, _ := SimpleTokenizer{}.Tokenize([]byte("hello "), false)
+	s.Equal(5, n)
+	s.Equal(WordToken, kind)
+
+	n, _, _ = SimpleTokenizer{}.Tokenize([]byte("caf\xc3"), false)
+	s.Equal(0, n)
+}
+
+func (s *TokenizerSuite) TestRuleTokenizer() {
+	t := &RuleTokenizer{
+		IsWordRune: func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+		},
+		Joiners: "'",
+	}
+
+	s.Equal([]token{
+		{WordToken, "don't"},
+		{SpaceToken, " "},
+		{WordToken, "call_me2"},
+		{SpaceToken, " "},
+		{WordToken, "rock"},
+		{PunctToken, "'"},
+		{PunctToken, "'"},
+		{WordToken, "roll"},
+	}, tokenize(t, "don't call_me2 rock''roll"))
+}
+
+func (s *TokenizerSuite) TestRuleTokenizerBreaks() {
+	t := &RuleTokenizer{Breaks: "x"}
+	s.Equal([]token{
+		{WordToken, "bo"},
+		{PunctToken, "x"},
+		{WordToken, "es"},
+	}, tokenize(t, "boxes"))
+}
+
+func (s *TokenizerSuite) TestRuleTokenizerJoinerNeedsMoreData() {
+	t := &RuleTokenizer{Joiners: "'"}
+	n, _, _ := t.Tokenize([]byte("don'"), false)
+	s.Equal(0, n)
+
+	n, _, _ = t.Tokenize([]byte("don'"), true)
+	s.Equal(3, n)
+}
+
+func (s *TokenizerSuite) TestAnalyseWithTokenizer() {
+	text := "You'll see."
+
+	res, _ := Analyse(strings.NewReader(text), WithTokenizer(SimpleTokenizer{}))
+	s.Equal(3, res.Words)
+	s.Equal(2, res.Punctuation)
+
+	res, _ = Analyse(strings.NewReader(text), WithTokenizer(&RuleTokenizer{Joiners: "'"}))
+	s.Equal(2, res.Words)
+	s.Equal(8, res.Letters)
+	s.Equal(1, res.Punctuation)
+	s.Equal(1, res.Sentences)
+}
+
+func TestTokenizers(t *testing.T) {
+	suite.Run(t, new(TokenizerSuite))
+}
@@ -0,0 +1,66 @@
+package textstats
+
+import "fmt"
+
+const (
+	// MinSMOGSentences is the fewest sentences the SMOG index is reliable for
+	MinSMOGSentences = 30
+
+	// MinSampleWords is the fewest words the other readability scores are
+	// reliable for
+	MinSampleWords = 100
+)
+
+// sampleScores are the readability scores that need MinSampleWords words
+var sampleScores = [...]string{
+	"Flesch-Kincaid Reading Ease",
+	"Flesch-Kincaid Grade Level",
+	"Gunning-Fog Score",
+	"Coleman-Liau Index",
+	"Automated Readability Index",
+	"Dale-Chall Readability Score",
+}
+
+// smogScore is the name of the SMOG index in warnings
+const smogScore = "SMOG Index"
+
+// Warning describes a readability score that may be unreliable for the text
+// that was analysed
+type Warning struct {
+	// Score is the name of the score, such as "SMOG Index"
+	Score string
+
+	// Message explains why the score may be unreliable
+	Message string
+}
+
+// String returns the score and message
+func (w Warning) String() string {
+	return w.Score + ": " + w.Message
+}
+
+// Warnings returns a Warning for each readability score that may be
+// unreliable because the text is too short, or nil if there are none
+func (r *Results) Warnings() []Warning {
+	var warnings []Warning
+
+	if r.Words < MinSampleWords {
+		message := fmt.Sprintf("only %d words, fewer than the %d needed", r.Words, MinSampleWords)
+		if r.Words == 0 {
+			message = "no words to score"
+		}
+		for _, score := range sampleScores {
+			warnings = append(warnings, Warning{Score: score, Message: message})
+		}
+	}
+
+	if r.Sentences < MinSMOGSentences {
+		message := fmt.Sprintf("only %d sentences, fewer than the %d needed", r.Sentences, MinSMOGSentences)
+		if r.Words == 0 {
+			message = "no words to score"
+		}
+		warnings = append(warnings, Warning{Score: smogScore, Message: message})
+	}
+
+	return warnings
+}
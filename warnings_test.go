@@ -0,0 +1,41 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WarningsSuite struct {
+	suite.Suite
+}
+
+func (s *WarningsSuite) TestShortText() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	warnings := res.Warnings()
+	s.Len(warnings, len(sampleScores)+1)
+	s.Equal(Warning{Score: "SMOG Index", Message: "only 1 sentences, fewer than the 30 needed"}, warnings[len(warnings)-1])
+	s.Equal("Flesch-Kincaid Reading Ease: only 9 words, fewer than the 100 needed", warnings[0].String())
+}
+
+func (s *WarningsSuite) TestNoWords() {
+	res, _ := Analyse(strings.NewReader(""))
+	for _, warning := range res.Warnings() {
+		s.Equal("no words to score", warning.Message)
+	}
+}
+
+func (s *WarningsSuite) TestLongText() {
+	res, _ := Analyse(strings.NewReader(strings.Repeat(qbf+". ", MinSMOGSentences)))
+	s.Nil(res.Warnings())
+
+	res, _ = Analyse(strings.NewReader(strings.Repeat("Go on. ", MinSMOGSentences)))
+	warnings := res.Warnings()
+	s.Len(warnings, len(sampleScores))
+	s.Equal("only 60 words, fewer than the 100 needed", warnings[0].Message)
+}
+
+func TestWarnings(t *testing.T) {
+	suite.Run(t, new(WarningsSuite))
+}